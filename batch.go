@@ -0,0 +1,399 @@
+package gosquare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// MaxBatchRequests is the most BatchRequests SubmitBatch sends at once.
+const MaxBatchRequests = 30
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type SubmitBatchReqObject struct {
+	// The requests to perform.
+	Requests []*BatchRequest `json:"requests"`
+}
+
+// Lets you batch multiple requests to other Connect API endpoints into a single request. This
+// endpoint's response is an array that contains the response for each batched request.
+//
+// You don't need to provide an access token in the header of your request to the Submit Batch
+// endpoint. Instead, you provide an access_token parameter for each request included in
+// the batch.
+//
+// Note the following when using the Submit Batch endpoint:
+func (c *Client) SubmitBatch(token string, batchRequests []*BatchRequest) ([]*BatchResponse, error) {
+	return c.SubmitBatchContext(context.Background(), token, batchRequests)
+}
+
+// SubmitBatchContext is like SubmitBatch but uses ctx for the request.
+func (c *Client) SubmitBatchContext(ctx context.Context, token string, batchRequests []*BatchRequest) ([]*BatchResponse, error) {
+	return c.SubmitBatchWithOptionsContext(ctx, token, batchRequests, nil)
+}
+
+// SubmitBatchOptions are the options of SubmitBatchWithOptions.
+type SubmitBatchOptions struct {
+	// FollowPages makes SubmitBatch fetch every page of the list requests of the
+	// batch, like their direct counterparts' iterators do. The following pages
+	// are fetched in batches too, and their results appended to the result of
+	// the first page. If a following page fails, its response replaces the first
+	// page's status code, headers and body, so BatchResponse.Err reports it.
+	FollowPages bool
+}
+
+// SubmitBatchWithOptions is like SubmitBatch but takes its options as
+// a SubmitBatchOptions, nil for none.
+func (c *Client) SubmitBatchWithOptions(token string, batchRequests []*BatchRequest, opts *SubmitBatchOptions) ([]*BatchResponse, error) {
+	return c.SubmitBatchWithOptionsContext(context.Background(), token, batchRequests, opts)
+}
+
+// SubmitBatchWithOptionsContext is like SubmitBatchWithOptions but uses ctx for the requests.
+func (c *Client) SubmitBatchWithOptionsContext(ctx context.Context, token string, batchRequests []*BatchRequest, opts *SubmitBatchOptions) ([]*BatchResponse, error) {
+	v, err := c.submitBatch(ctx, token, batchRequests)
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil && opts.FollowPages {
+		if err := c.followBatchPages(ctx, token, v); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+func (c *Client) submitBatch(ctx context.Context, token string, batchRequests []*BatchRequest) ([]*BatchResponse, error) {
+	if len(batchRequests) > MaxBatchRequests {
+		return nil, fmt.Errorf("You cannot submit more than %d requests to `/v1/batch`", MaxBatchRequests)
+	}
+	for _, br := range batchRequests {
+		br.response = nil
+	}
+	reqObj := new(SubmitBatchReqObject)
+	reqObj.Requests = batchRequests
+	if !hasWrites(batchRequests) {
+		// a batch of idempotent requests is safe to retry as a whole
+		ctx = AllowWriteRetry(ctx)
+	}
+	body, err := json.Marshal(reqObj)
+	if err != nil {
+		return nil, err
+	}
+	v := make([]*BatchResponse, 0)
+	_, err = c.baseSquareRequest(ctx, &Request{
+		Operation:   "SubmitBatch",
+		Method:      "POST",
+		Path:        "/v1/batch",
+		Token:       token,
+		ContentType: "application/json",
+		Body:        body,
+		Result:      &v,
+		Batch:       batchRequests,
+	})
+	if err != nil {
+		return nil, err
+	}
+	reqMap := make(map[string]*BatchRequest)
+	for _, br := range reqObj.Requests {
+		reqMap[br.RequestID] = br
+	}
+	for _, bResp := range v {
+		var result interface{}
+		if bReq, ok := reqMap[bResp.RequestID]; ok {
+			bResp.request = bReq
+			bReq.response = bResp
+			if bReq.Method != "DELETE" && isSuccess(bResp.StatusCode) {
+				// an unreadable response fails on its own, the others may be
+				// writes Square already applied
+				if link := bResp.Headers.Get("Link"); len(link) > 0 {
+					bResp.NextRequest, bResp.err = c.newNextRequest(ctx, bReq.operation, link, bReq.AccessToken, 1)
+				}
+				result = bReq.result
+			}
+		}
+		if err := bResp.decodeBody(result); err != nil && bResp.err == nil {
+			bResp.err = err
+		}
+	}
+	return v, nil
+}
+
+// BatchHeaders are the headers of a BatchResponse, indexed by header name.
+type BatchHeaders map[string]string
+
+// Get returns the value of the header named name, ignoring case,
+// "" if there is no such header.
+func (h BatchHeaders) Get(name string) string {
+	if v, ok := h[name]; ok {
+		return v
+	}
+	for k, v := range h {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return ""
+}
+
+// UnmarshalJSON decodes a JSON object of headers. Values that aren't strings,
+// which Square doesn't send, are kept as their JSON text.
+func (h *BatchHeaders) UnmarshalJSON(bts []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(bts, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		*h = nil
+		return nil
+	}
+	*h = make(BatchHeaders, len(raw))
+	for k, v := range raw {
+		var s string
+		if err := json.Unmarshal(v, &s); err != nil {
+			s = string(v)
+		}
+		(*h)[k] = s
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes a BatchResponse but its body, which is kept as is
+// until SubmitBatch decodes it, once, into the result of its request.
+func (br *BatchResponse) UnmarshalJSON(bts []byte) error {
+	type plain BatchResponse
+	v := struct {
+		*plain
+		Body json.RawMessage `json:"body"`
+	}{plain: (*plain)(br)}
+	if err := json.Unmarshal(bts, &v); err != nil {
+		return err
+	}
+	br.body = v.Body
+	return nil
+}
+
+// decodeBody decodes the body into result, or into Body as Square returned
+// it if result is nil.
+func (br *BatchResponse) decodeBody(result interface{}) error {
+	if result == nil {
+		result = &br.Body
+	} else {
+		br.Body = result
+	}
+	if len(br.body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(br.body, result)
+}
+
+// Operation returns the name of the endpoint the request calls, the name
+// of its function, for example "RetrievePayment". See Request.Operation.
+func (br *BatchRequest) Operation() string {
+	return br.operation
+}
+
+// ErrNoBatchResponse is returned by BatchCall.Result when the call's request
+// has no response, because it wasn't submitted, its batch failed or Square
+// left it out of the batch response.
+var ErrNoBatchResponse = errors.New("gosquare: no response to the batch request")
+
+// ErrBatchResultType is returned, wrapped, by BatchCall.Result when the call's
+// result isn't a T, for example when a BatchCall is made for a reloaded
+// BatchPlan request of another operation.
+var ErrBatchResultType = errors.New("gosquare: batch call result has the wrong type")
+
+// BatchCall is a BatchRequest whose result is typed, returned by the BatchCall
+// methods. Submit its BatchRequest with SubmitBatch or ExecuteBatch, then get
+// its result with Result:
+//
+//	item := client.RetrieveItemBatchCall(token, locationID, itemID)
+//	fees := client.ListFeesBatchCall(token, locationID)
+//	_, err := client.SubmitBatch(token, []*gosquare.BatchRequest{item.BatchRequest, fees.BatchRequest})
+//	...
+//	i, err := item.Result()
+type BatchCall[T any] struct {
+	*BatchRequest
+}
+
+// Result returns the result of the call once its request was submitted. If the
+// call failed, Result returns an *APIError, see BatchResponse.Err. Calls without
+// a result, such as deletions, return the zero value of T. A result that isn't
+// a T is reported with ErrBatchResultType.
+func (bc *BatchCall[T]) Result() (T, error) {
+	var zero T
+	resp := bc.response
+	if resp == nil {
+		return zero, ErrNoBatchResponse
+	}
+	if err := resp.Err(); err != nil {
+		return zero, err
+	}
+	switch v := bc.result.(type) {
+	case nil:
+		return zero, nil
+	case T:
+		return v, nil
+	case *T:
+		return *v, nil
+	}
+	return zero, fmt.Errorf("%w: %s returns a %T, not a %T", ErrBatchResultType, bc.operation, bc.result, zero)
+}
+
+// followBatchPages fetches the pages following resps, one batch per page
+// depth, until none of them has a next page.
+func (c *Client) followBatchPages(ctx context.Context, token string, resps []*BatchResponse) error {
+	var pending []*BatchResponse
+	for _, r := range resps {
+		if r.NextRequest != nil && r.request != nil && isSlicePtr(r.request.result) {
+			pending = append(pending, r)
+		}
+	}
+	for len(pending) > 0 {
+		reqs := make([]*BatchRequest, len(pending))
+		pages := make([]reflect.Value, len(pending))
+		for i, r := range pending {
+			pages[i] = reflect.New(reflect.TypeOf(r.request.result).Elem())
+			reqs[i], _ = r.NextRequest.GetNextRequestAsBatchRequest(pages[i].Interface())
+		}
+		if _, err := c.submitBatch(ctx, token, reqs); err != nil {
+			return err
+		}
+		var next []*BatchResponse
+		for i, r := range pending {
+			pr := reqs[i].response
+			if pr == nil {
+				// left out by Square, r keeps its NextRequest
+				continue
+			}
+			r.NextRequest = pr.NextRequest
+			if !isSuccess(pr.StatusCode) {
+				r.StatusCode, r.Headers, r.Body, r.body = pr.StatusCode, pr.Headers, pr.Body, pr.body
+				r.NextRequest = nil
+				continue
+			}
+			if pr.err != nil {
+				r.err, r.NextRequest = pr.err, nil
+				continue
+			}
+			results := reflect.ValueOf(r.request.result).Elem()
+			results.Set(reflect.AppendSlice(results, pages[i].Elem()))
+			if r.NextRequest != nil {
+				next = append(next, r)
+			}
+		}
+		pending = next
+	}
+	return nil
+}
+
+func isSlicePtr(v interface{}) bool {
+	t := reflect.TypeOf(v)
+	return t != nil && t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Slice
+}
+
+// BatchResults are the responses of ExecuteBatch.
+type BatchResults struct {
+	// The responses, in the order of the requests. A response is nil if its
+	// chunk failed or if Square left it out of the batch response.
+	Responses []*BatchResponse
+	// The responses, indexed by the RequestID of their request.
+	ByRequestID map[string]*BatchResponse
+	// The errors of the chunks that failed, in the order of the chunks.
+	Errors []*BatchChunkError
+}
+
+// BatchChunkError is the error of a chunk of ExecuteBatch's requests,
+// requests[Start:End], that failed as a whole. The requests of the
+// chunk have no response.
+type BatchChunkError struct {
+	Start int
+	End   int
+	Err   error
+}
+
+func (e *BatchChunkError) Error() string {
+	return fmt.Sprintf("gosquare: batch requests %d to %d: %v", e.Start, e.End-1, e.Err)
+}
+
+func (e *BatchChunkError) Unwrap() error {
+	return e.Err
+}
+
+// Err returns the errors of the chunks that failed joined together,
+// nil if every chunk succeeded.
+func (r *BatchResults) Err() error {
+	errs := make([]error, len(r.Errors))
+	for i, e := range r.Errors {
+		errs[i] = e
+	}
+	return errors.Join(errs...)
+}
+
+// ExecuteBatch submits any number of BatchRequests, splitting them into chunks
+// of MaxBatchRequests sent with SubmitBatch, at most concurrency at a time.
+// A concurrency of 0 or less sends them one at a time.
+//
+// A chunk that fails doesn't stop the others, its error is reported in the
+// results' Errors and returned, joined, along with the responses of the other
+// chunks. The requests' RequestIDs must be unique, requests without one are
+// given one. A response with a failed status code is not an error here, see
+// BatchResponse.Err.
+func (c *Client) ExecuteBatch(token string, batchRequests []*BatchRequest, concurrency int) (*BatchResults, error) {
+	return c.ExecuteBatchContext(context.Background(), token, batchRequests, concurrency)
+}
+
+// ExecuteBatchContext is like ExecuteBatch but uses ctx for the requests.
+func (c *Client) ExecuteBatchContext(ctx context.Context, token string, batchRequests []*BatchRequest, concurrency int) (*BatchResults, error) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	for _, br := range batchRequests {
+		if len(br.RequestID) == 0 {
+			br.RequestID = newUUID()
+		}
+	}
+	res := &BatchResults{
+		Responses:   make([]*BatchResponse, len(batchRequests)),
+		ByRequestID: make(map[string]*BatchResponse, len(batchRequests)),
+	}
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	for start := 0; start < len(batchRequests); start += MaxBatchRequests {
+		end := min(start+MaxBatchRequests, len(batchRequests))
+		chunk := batchRequests[start:end]
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			resps, err := c.SubmitBatchContext(ctx, token, chunk)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				res.Errors = append(res.Errors, &BatchChunkError{Start: start, End: end, Err: err})
+				return
+			}
+			for _, resp := range resps {
+				res.ByRequestID[resp.RequestID] = resp
+			}
+			for i, br := range chunk {
+				res.Responses[start+i] = res.ByRequestID[br.RequestID]
+			}
+		}()
+	}
+	wg.Wait()
+	slices.SortFunc(res.Errors, func(a, b *BatchChunkError) int {
+		return a.Start - b.Start
+	})
+	return res, res.Err()
+}
@@ -1,509 +1,1246 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
 package gosquare
 
 import "fmt"
 
 // RetrieveBusinessBatchRequest returns a BatchRequest object for RetrieveBusiness,
 // along with a unique request id.
-func RetrieveBusinessBatchRequest(token string) (*BatchRequest, string) {
+func (c *Client) RetrieveBusinessBatchRequest(token string) (*BatchRequest, string) {
 	v := new(Merchant)
-	return newBatchRequest("GET", "/v1/me", token, nil, v)
+	return c.newBatchRequest("RetrieveBusiness", "GET", "/v1/me", token, nil, v)
+}
+
+// RetrieveBusinessBatchCall is like RetrieveBusinessBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveBusinessBatchCall(token string) *BatchCall[*Merchant] {
+	br, _ := c.RetrieveBusinessBatchRequest(token)
+	return &BatchCall[*Merchant]{br}
 }
 
 // ListLocationsBatchRequest returns a BatchRequest object for ListLocations,
 // along with a unique request id.
-func ListLocationsBatchRequest(token string) (*BatchRequest, string) {
+func (c *Client) ListLocationsBatchRequest(token string) (*BatchRequest, string) {
 	v := make([]*Merchant, 0)
-	return newBatchRequest("GET", "/v1/me/locations", token, nil, &v)
+	return c.newBatchRequest("ListLocations", "GET", "/v1/me/locations", token, nil, &v)
+}
+
+// ListLocationsBatchCall is like ListLocationsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListLocationsBatchCall(token string) *BatchCall[[]*Merchant] {
+	br, _ := c.ListLocationsBatchRequest(token)
+	return &BatchCall[[]*Merchant]{br}
 }
 
 // CreateEmployeeBatchRequest returns a BatchRequest object for CreateEmployee,
 // along with a unique request id.
-func CreateEmployeeBatchRequest(token string, reqObj *CreateEmployeeReqObject) (*BatchRequest, string) {
+func (c *Client) CreateEmployeeBatchRequest(token string, reqObj *CreateEmployeeReqObject) (*BatchRequest, string) {
 	v := new(Employee)
-	return newBatchRequest("POST", "/v1/me/employees", token, reqObj, v)
+	return c.newBatchRequest("CreateEmployee", "POST", "/v1/me/employees", token, reqObj, v)
+}
+
+// CreateEmployeeBatchCall is like CreateEmployeeBatchRequest but returns a typed BatchCall.
+func (c *Client) CreateEmployeeBatchCall(token string, reqObj *CreateEmployeeReqObject) *BatchCall[*Employee] {
+	br, _ := c.CreateEmployeeBatchRequest(token, reqObj)
+	return &BatchCall[*Employee]{br}
 }
 
 // ListEmployeesBatchRequest returns a BatchRequest object for ListEmployees,
 // along with a unique request id.
-func ListEmployeesBatchRequest(token string, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID string, limit int) (*BatchRequest, string) {
+//
+// Deprecated: use ListEmployeesWithOptionsBatchRequest, which takes typed filters.
+func (c *Client) ListEmployeesBatchRequest(token, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID string, limit int) (*BatchRequest, string) {
+	v := make([]*Employee, 0)
+	return c.newBatchRequest("ListEmployees", "GET", listEmployeesPath(order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID, limit), token, nil, &v)
+}
+
+// ListEmployeesWithOptionsBatchRequest returns a BatchRequest object for
+// ListEmployeesWithOptions, along with a unique request id.
+func (c *Client) ListEmployeesWithOptionsBatchRequest(token string, opts *ListEmployeesOptions) (*BatchRequest, string) {
 	v := make([]*Employee, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/me/employees?order=%s&begin_updated_at=%s&end_updated_at=%s&begin_created_at=%s&end_created_at=%s&status=%s&external_id=%s&limit=%d", order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID, limit), token, nil, &v)
+	return c.newBatchRequest("ListEmployees", "GET", opts.path(), token, nil, &v)
+}
+
+// ListEmployeesWithOptionsBatchCall is like ListEmployeesWithOptionsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListEmployeesWithOptionsBatchCall(token string, opts *ListEmployeesOptions) *BatchCall[[]*Employee] {
+	br, _ := c.ListEmployeesWithOptionsBatchRequest(token, opts)
+	return &BatchCall[[]*Employee]{br}
 }
 
 // RetrieveEmployeeBatchRequest returns a BatchRequest object for RetrieveEmployee,
 // along with a unique request id.
-func RetrieveEmployeeBatchRequest(token, employeeID string) (*BatchRequest, string) {
+func (c *Client) RetrieveEmployeeBatchRequest(token, employeeID string) (*BatchRequest, string) {
 	v := new(Employee)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/me/employees/%s", employeeID), token, nil, v)
+	return c.newBatchRequest("RetrieveEmployee", "GET", fmt.Sprintf("/v1/me/employees/%s", employeeID), token, nil, v)
+}
+
+// RetrieveEmployeeBatchCall is like RetrieveEmployeeBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveEmployeeBatchCall(token, employeeID string) *BatchCall[*Employee] {
+	br, _ := c.RetrieveEmployeeBatchRequest(token, employeeID)
+	return &BatchCall[*Employee]{br}
 }
 
 // UpdateEmployeeBatchRequest returns a BatchRequest object for UpdateEmployee,
 // along with a unique request id.
-func UpdateEmployeeBatchRequest(token, employeeID string, reqObj *UpdateEmployeeReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateEmployeeBatchRequest(token, employeeID string, reqObj *UpdateEmployeeReqObject) (*BatchRequest, string) {
 	v := new(Employee)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/me/employees/%s", employeeID), token, reqObj, v)
+	return c.newBatchRequest("UpdateEmployee", "PUT", fmt.Sprintf("/v1/me/employees/%s", employeeID), token, reqObj, v)
+}
+
+// UpdateEmployeeBatchCall is like UpdateEmployeeBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateEmployeeBatchCall(token, employeeID string, reqObj *UpdateEmployeeReqObject) *BatchCall[*Employee] {
+	br, _ := c.UpdateEmployeeBatchRequest(token, employeeID, reqObj)
+	return &BatchCall[*Employee]{br}
 }
 
 // CreateRoleBatchRequest returns a BatchRequest object for CreateRole,
 // along with a unique request id.
-func CreateRoleBatchRequest(token string, reqObj *CreateRoleReqObject) (*BatchRequest, string) {
+func (c *Client) CreateRoleBatchRequest(token string, reqObj *CreateRoleReqObject) (*BatchRequest, string) {
 	v := new(EmployeeRole)
-	return newBatchRequest("POST", "/v1/me/roles", token, reqObj, v)
+	return c.newBatchRequest("CreateRole", "POST", "/v1/me/roles", token, reqObj, v)
+}
+
+// CreateRoleBatchCall is like CreateRoleBatchRequest but returns a typed BatchCall.
+func (c *Client) CreateRoleBatchCall(token string, reqObj *CreateRoleReqObject) *BatchCall[*EmployeeRole] {
+	br, _ := c.CreateRoleBatchRequest(token, reqObj)
+	return &BatchCall[*EmployeeRole]{br}
 }
 
 // ListRolesBatchRequest returns a BatchRequest object for ListRoles,
 // along with a unique request id.
-func ListRolesBatchRequest(token, order string, limit int) (*BatchRequest, string) {
+//
+// Deprecated: use ListRolesWithOptionsBatchRequest, which takes typed filters.
+func (c *Client) ListRolesBatchRequest(token, order string, limit int) (*BatchRequest, string) {
 	v := make([]*EmployeeRole, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/me/roles?order=%s&limit=%d", order, limit), token, nil, &v)
+	return c.newBatchRequest("ListRoles", "GET", listRolesPath(order, limit), token, nil, &v)
+}
+
+// ListRolesWithOptionsBatchRequest returns a BatchRequest object for
+// ListRolesWithOptions, along with a unique request id.
+func (c *Client) ListRolesWithOptionsBatchRequest(token string, opts *ListRolesOptions) (*BatchRequest, string) {
+	v := make([]*EmployeeRole, 0)
+	return c.newBatchRequest("ListRoles", "GET", opts.path(), token, nil, &v)
+}
+
+// ListRolesWithOptionsBatchCall is like ListRolesWithOptionsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListRolesWithOptionsBatchCall(token string, opts *ListRolesOptions) *BatchCall[[]*EmployeeRole] {
+	br, _ := c.ListRolesWithOptionsBatchRequest(token, opts)
+	return &BatchCall[[]*EmployeeRole]{br}
 }
 
 // RetrieveRoleBatchRequest returns a BatchRequest object for RetrieveRole,
 // along with a unique request id.
-func RetrieveRoleBatchRequest(token, roleID string) (*BatchRequest, string) {
+func (c *Client) RetrieveRoleBatchRequest(token, roleID string) (*BatchRequest, string) {
 	v := new(EmployeeRole)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/me/roles/%s", roleID), token, nil, v)
+	return c.newBatchRequest("RetrieveRole", "GET", fmt.Sprintf("/v1/me/roles/%s", roleID), token, nil, v)
+}
+
+// RetrieveRoleBatchCall is like RetrieveRoleBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveRoleBatchCall(token, roleID string) *BatchCall[*EmployeeRole] {
+	br, _ := c.RetrieveRoleBatchRequest(token, roleID)
+	return &BatchCall[*EmployeeRole]{br}
 }
 
 // UpdateRoleBatchRequest returns a BatchRequest object for UpdateRole,
 // along with a unique request id.
-func UpdateRoleBatchRequest(token, roleID string, reqObj *UpdateRoleReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateRoleBatchRequest(token, roleID string, reqObj *UpdateRoleReqObject) (*BatchRequest, string) {
 	v := new(EmployeeRole)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/me/roles/%s", roleID), token, reqObj, v)
+	return c.newBatchRequest("UpdateRole", "PUT", fmt.Sprintf("/v1/me/roles/%s", roleID), token, reqObj, v)
+}
+
+// UpdateRoleBatchCall is like UpdateRoleBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateRoleBatchCall(token, roleID string, reqObj *UpdateRoleReqObject) *BatchCall[*EmployeeRole] {
+	br, _ := c.UpdateRoleBatchRequest(token, roleID, reqObj)
+	return &BatchCall[*EmployeeRole]{br}
 }
 
 // CreateTimecardBatchRequest returns a BatchRequest object for CreateTimecard,
 // along with a unique request id.
-func CreateTimecardBatchRequest(token string, reqObj *CreateTimecardReqObject) (*BatchRequest, string) {
+func (c *Client) CreateTimecardBatchRequest(token string, reqObj *CreateTimecardReqObject) (*BatchRequest, string) {
 	v := new(Timecard)
-	return newBatchRequest("POST", "/v1/me/timecards", token, reqObj, v)
+	return c.newBatchRequest("CreateTimecard", "POST", "/v1/me/timecards", token, reqObj, v)
+}
+
+// CreateTimecardBatchCall is like CreateTimecardBatchRequest but returns a typed BatchCall.
+func (c *Client) CreateTimecardBatchCall(token string, reqObj *CreateTimecardReqObject) *BatchCall[*Timecard] {
+	br, _ := c.CreateTimecardBatchRequest(token, reqObj)
+	return &BatchCall[*Timecard]{br}
 }
 
 // ListTimecardsBatchRequest returns a BatchRequest object for ListTimecards,
 // along with a unique request id.
-func ListTimecardsBatchRequest(token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt string, deleted bool, limit int) (*BatchRequest, string) {
+//
+// Deprecated: use ListTimecardsWithOptionsBatchRequest, which takes typed filters.
+func (c *Client) ListTimecardsBatchRequest(token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt string, deleted bool, limit int) (*BatchRequest, string) {
+	v := make([]*Timecard, 0)
+	return c.newBatchRequest("ListTimecards", "GET", listTimecardsPath(order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt, &deleted, limit), token, nil, &v)
+}
+
+// ListTimecardsWithOptionsBatchRequest returns a BatchRequest object for
+// ListTimecardsWithOptions, along with a unique request id.
+func (c *Client) ListTimecardsWithOptionsBatchRequest(token string, opts *ListTimecardsOptions) (*BatchRequest, string) {
 	v := make([]*Timecard, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/me/timecards?order=%s&employee_id=%s&begin_clockin_time=%s&end_clockin_time=%s&begin_clockout_time=%s&end_clockout_time=%s&begin_updated_at=%s&end_updated_at=%s&deleted=%t&limit=%d", order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt, deleted, limit), token, nil, &v)
+	return c.newBatchRequest("ListTimecards", "GET", opts.path(), token, nil, &v)
+}
+
+// ListTimecardsWithOptionsBatchCall is like ListTimecardsWithOptionsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListTimecardsWithOptionsBatchCall(token string, opts *ListTimecardsOptions) *BatchCall[[]*Timecard] {
+	br, _ := c.ListTimecardsWithOptionsBatchRequest(token, opts)
+	return &BatchCall[[]*Timecard]{br}
 }
 
 // RetrieveTimecardBatchRequest returns a BatchRequest object for RetrieveTimecard,
 // along with a unique request id.
-func RetrieveTimecardBatchRequest(token, timecardID string) (*BatchRequest, string) {
+func (c *Client) RetrieveTimecardBatchRequest(token, timecardID string) (*BatchRequest, string) {
 	v := new(Timecard)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, nil, v)
+	return c.newBatchRequest("RetrieveTimecard", "GET", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, nil, v)
+}
+
+// RetrieveTimecardBatchCall is like RetrieveTimecardBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveTimecardBatchCall(token, timecardID string) *BatchCall[*Timecard] {
+	br, _ := c.RetrieveTimecardBatchRequest(token, timecardID)
+	return &BatchCall[*Timecard]{br}
 }
 
 // UpdateTimecardBatchRequest returns a BatchRequest object for UpdateTimecard,
 // along with a unique request id.
-func UpdateTimecardBatchRequest(token, timecardID string, reqObj *UpdateTimecardReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateTimecardBatchRequest(token, timecardID string, reqObj *UpdateTimecardReqObject) (*BatchRequest, string) {
 	v := new(Timecard)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, reqObj, v)
+	return c.newBatchRequest("UpdateTimecard", "PUT", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, reqObj, v)
+}
+
+// UpdateTimecardBatchCall is like UpdateTimecardBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateTimecardBatchCall(token, timecardID string, reqObj *UpdateTimecardReqObject) *BatchCall[*Timecard] {
+	br, _ := c.UpdateTimecardBatchRequest(token, timecardID, reqObj)
+	return &BatchCall[*Timecard]{br}
 }
 
 // DeleteTimecardBatchRequest returns a BatchRequest object for DeleteTimecard,
 // along with a unique request id.
-func DeleteTimecardBatchRequest(token, timecardID string) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, nil, nil)
+func (c *Client) DeleteTimecardBatchRequest(token, timecardID string) (*BatchRequest, string) {
+	return c.newBatchRequest("DeleteTimecard", "DELETE", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, nil, nil)
+}
+
+// DeleteTimecardBatchCall is like DeleteTimecardBatchRequest but returns a typed BatchCall.
+func (c *Client) DeleteTimecardBatchCall(token, timecardID string) *BatchCall[struct{}] {
+	br, _ := c.DeleteTimecardBatchRequest(token, timecardID)
+	return &BatchCall[struct{}]{br}
 }
 
 // ListTimecardEventsBatchRequest returns a BatchRequest object for ListTimecardEvents,
 // along with a unique request id.
-func ListTimecardEventsBatchRequest(token, timecardID string) (*BatchRequest, string) {
+func (c *Client) ListTimecardEventsBatchRequest(token, timecardID string) (*BatchRequest, string) {
 	v := make([]*TimecardEvent, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/me/timecards/%s/events", timecardID), token, nil, &v)
+	return c.newBatchRequest("ListTimecardEvents", "GET", fmt.Sprintf("/v1/me/timecards/%s/events", timecardID), token, nil, &v)
+}
+
+// ListTimecardEventsBatchCall is like ListTimecardEventsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListTimecardEventsBatchCall(token, timecardID string) *BatchCall[[]*TimecardEvent] {
+	br, _ := c.ListTimecardEventsBatchRequest(token, timecardID)
+	return &BatchCall[[]*TimecardEvent]{br}
 }
 
 // ListCashDrawerShiftsBatchRequest returns a BatchRequest object for ListCashDrawerShifts,
 // along with a unique request id.
-func ListCashDrawerShiftsBatchRequest(token, locationID, beginTime, endTime, order string) (*BatchRequest, string) {
+//
+// Deprecated: use ListCashDrawerShiftsWithOptionsBatchRequest, which takes typed filters.
+func (c *Client) ListCashDrawerShiftsBatchRequest(token, locationID, beginTime, endTime, order string) (*BatchRequest, string) {
 	v := make([]*CashDrawerShift, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/cash-drawer-shifts?begin_time=%s&end_time=%s&order=%s", locationID, beginTime, endTime, order), token, nil, &v)
+	return c.newBatchRequest("ListCashDrawerShifts", "GET", listCashDrawerShiftsPath(locationID, beginTime, endTime, order), token, nil, &v)
+}
+
+// ListCashDrawerShiftsWithOptionsBatchRequest returns a BatchRequest object for
+// ListCashDrawerShiftsWithOptions, along with a unique request id.
+func (c *Client) ListCashDrawerShiftsWithOptionsBatchRequest(token, locationID string, opts *ListCashDrawerShiftsOptions) (*BatchRequest, string) {
+	v := make([]*CashDrawerShift, 0)
+	return c.newBatchRequest("ListCashDrawerShifts", "GET", opts.path(locationID), token, nil, &v)
+}
+
+// ListCashDrawerShiftsWithOptionsBatchCall is like ListCashDrawerShiftsWithOptionsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListCashDrawerShiftsWithOptionsBatchCall(token, locationID string, opts *ListCashDrawerShiftsOptions) *BatchCall[[]*CashDrawerShift] {
+	br, _ := c.ListCashDrawerShiftsWithOptionsBatchRequest(token, locationID, opts)
+	return &BatchCall[[]*CashDrawerShift]{br}
 }
 
 // RetrieveCashDrawerShiftBatchRequest returns a BatchRequest object for RetrieveCashDrawerShift,
 // along with a unique request id.
-func RetrieveCashDrawerShiftBatchRequest(token, locationID, shiftID string) (*BatchRequest, string) {
+func (c *Client) RetrieveCashDrawerShiftBatchRequest(token, locationID, shiftID string) (*BatchRequest, string) {
 	v := new(CashDrawerShift)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/cash-drawer-shifts/%s", locationID, shiftID), token, nil, v)
+	return c.newBatchRequest("RetrieveCashDrawerShift", "GET", fmt.Sprintf("/v1/%s/cash-drawer-shifts/%s", locationID, shiftID), token, nil, v)
+}
+
+// RetrieveCashDrawerShiftBatchCall is like RetrieveCashDrawerShiftBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveCashDrawerShiftBatchCall(token, locationID, shiftID string) *BatchCall[*CashDrawerShift] {
+	br, _ := c.RetrieveCashDrawerShiftBatchRequest(token, locationID, shiftID)
+	return &BatchCall[*CashDrawerShift]{br}
 }
 
 // ListPaymentsBatchRequest returns a BatchRequest object for ListPayments,
 // along with a unique request id.
-func ListPaymentsBatchRequest(token, locationID, beginTime, endTime, order string, limit int) (*BatchRequest, string) {
+//
+// Deprecated: use ListPaymentsWithOptionsBatchRequest, which takes typed filters.
+func (c *Client) ListPaymentsBatchRequest(token, locationID, beginTime, endTime, order string, limit int) (*BatchRequest, string) {
+	v := make([]*Payment, 0)
+	return c.newBatchRequest("ListPayments", "GET", listPaymentsPath(locationID, beginTime, endTime, order, limit), token, nil, &v)
+}
+
+// ListPaymentsWithOptionsBatchRequest returns a BatchRequest object for
+// ListPaymentsWithOptions, along with a unique request id.
+func (c *Client) ListPaymentsWithOptionsBatchRequest(token, locationID string, opts *ListPaymentsOptions) (*BatchRequest, string) {
 	v := make([]*Payment, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/payments?begin_time=%s&end_time=%s&order=%s&limit=%d", locationID, beginTime, endTime, order, limit), token, nil, &v)
+	return c.newBatchRequest("ListPayments", "GET", opts.path(locationID), token, nil, &v)
+}
+
+// ListPaymentsWithOptionsBatchCall is like ListPaymentsWithOptionsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListPaymentsWithOptionsBatchCall(token, locationID string, opts *ListPaymentsOptions) *BatchCall[[]*Payment] {
+	br, _ := c.ListPaymentsWithOptionsBatchRequest(token, locationID, opts)
+	return &BatchCall[[]*Payment]{br}
 }
 
 // RetrievePaymentBatchRequest returns a BatchRequest object for RetrievePayment,
 // along with a unique request id.
-func RetrievePaymentBatchRequest(token, locationID, paymentID string) (*BatchRequest, string) {
+func (c *Client) RetrievePaymentBatchRequest(token, locationID, paymentID string) (*BatchRequest, string) {
 	v := new(Payment)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/payments/%s", locationID, paymentID), token, nil, v)
+	return c.newBatchRequest("RetrievePayment", "GET", fmt.Sprintf("/v1/%s/payments/%s", locationID, paymentID), token, nil, v)
+}
+
+// RetrievePaymentBatchCall is like RetrievePaymentBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrievePaymentBatchCall(token, locationID, paymentID string) *BatchCall[*Payment] {
+	br, _ := c.RetrievePaymentBatchRequest(token, locationID, paymentID)
+	return &BatchCall[*Payment]{br}
 }
 
 // ListSettlementsBatchRequest returns a BatchRequest object for ListSettlements,
 // along with a unique request id.
-func ListSettlementsBatchRequest(token, locationID, beginTime, endTime, order string, limit int, status string) (*BatchRequest, string) {
+//
+// Deprecated: use ListSettlementsWithOptionsBatchRequest, which takes typed filters.
+func (c *Client) ListSettlementsBatchRequest(token, locationID, beginTime, endTime, order string, limit int, status string) (*BatchRequest, string) {
+	v := make([]*Settlement, 0)
+	return c.newBatchRequest("ListSettlements", "GET", listSettlementsPath(locationID, beginTime, endTime, order, limit, status), token, nil, &v)
+}
+
+// ListSettlementsWithOptionsBatchRequest returns a BatchRequest object for
+// ListSettlementsWithOptions, along with a unique request id.
+func (c *Client) ListSettlementsWithOptionsBatchRequest(token, locationID string, opts *ListSettlementsOptions) (*BatchRequest, string) {
 	v := make([]*Settlement, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/settlements?begin_time=%s&end_time=%s&order=%s&limit=%d&status=%s", locationID, beginTime, endTime, order, limit, status), token, nil, &v)
+	return c.newBatchRequest("ListSettlements", "GET", opts.path(locationID), token, nil, &v)
+}
+
+// ListSettlementsWithOptionsBatchCall is like ListSettlementsWithOptionsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListSettlementsWithOptionsBatchCall(token, locationID string, opts *ListSettlementsOptions) *BatchCall[[]*Settlement] {
+	br, _ := c.ListSettlementsWithOptionsBatchRequest(token, locationID, opts)
+	return &BatchCall[[]*Settlement]{br}
 }
 
 // RetrieveSettlementBatchRequest returns a BatchRequest object for RetrieveSettlement,
 // along with a unique request id.
-func RetrieveSettlementBatchRequest(token, locationID, settlementID string) (*BatchRequest, string) {
+func (c *Client) RetrieveSettlementBatchRequest(token, locationID, settlementID string) (*BatchRequest, string) {
 	v := new(Settlement)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/settlements/%s", locationID, settlementID), token, nil, v)
+	return c.newBatchRequest("RetrieveSettlement", "GET", fmt.Sprintf("/v1/%s/settlements/%s", locationID, settlementID), token, nil, v)
+}
+
+// RetrieveSettlementBatchCall is like RetrieveSettlementBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveSettlementBatchCall(token, locationID, settlementID string) *BatchCall[*Settlement] {
+	br, _ := c.RetrieveSettlementBatchRequest(token, locationID, settlementID)
+	return &BatchCall[*Settlement]{br}
 }
 
 // CreateRefundBatchRequest returns a BatchRequest object for CreateRefund,
 // along with a unique request id.
-func CreateRefundBatchRequest(token, locationID string, reqObj *CreateRefundReqObject) (*BatchRequest, string) {
+func (c *Client) CreateRefundBatchRequest(token, locationID string, reqObj *CreateRefundReqObject) (*BatchRequest, string) {
 	v := new(Refund)
-	return newBatchRequest("POST", fmt.Sprintf("/v1/%s/refunds", locationID), token, reqObj, v)
+	return c.newBatchRequest("CreateRefund", "POST", fmt.Sprintf("/v1/%s/refunds", locationID), token, reqObj, v)
+}
+
+// CreateRefundBatchCall is like CreateRefundBatchRequest but returns a typed BatchCall.
+func (c *Client) CreateRefundBatchCall(token, locationID string, reqObj *CreateRefundReqObject) *BatchCall[*Refund] {
+	br, _ := c.CreateRefundBatchRequest(token, locationID, reqObj)
+	return &BatchCall[*Refund]{br}
 }
 
 // ListRefundsBatchRequest returns a BatchRequest object for ListRefunds,
 // along with a unique request id.
-func ListRefundsBatchRequest(token, locationID, beginTime, endTime, order string, limit int) (*BatchRequest, string) {
+//
+// Deprecated: use ListRefundsWithOptionsBatchRequest, which takes typed filters.
+func (c *Client) ListRefundsBatchRequest(token, locationID, beginTime, endTime, order string, limit int) (*BatchRequest, string) {
 	v := make([]*Refund, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/refunds?begin_time=%s&end_time=%s&order=%s&limit=%d", locationID, beginTime, endTime, order, limit), token, nil, &v)
+	return c.newBatchRequest("ListRefunds", "GET", listRefundsPath(locationID, beginTime, endTime, order, limit), token, nil, &v)
+}
+
+// ListRefundsWithOptionsBatchRequest returns a BatchRequest object for
+// ListRefundsWithOptions, along with a unique request id.
+func (c *Client) ListRefundsWithOptionsBatchRequest(token, locationID string, opts *ListRefundsOptions) (*BatchRequest, string) {
+	v := make([]*Refund, 0)
+	return c.newBatchRequest("ListRefunds", "GET", opts.path(locationID), token, nil, &v)
+}
+
+// ListRefundsWithOptionsBatchCall is like ListRefundsWithOptionsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListRefundsWithOptionsBatchCall(token, locationID string, opts *ListRefundsOptions) *BatchCall[[]*Refund] {
+	br, _ := c.ListRefundsWithOptionsBatchRequest(token, locationID, opts)
+	return &BatchCall[[]*Refund]{br}
 }
 
 // ListOrdersBatchRequest returns a BatchRequest object for ListOrders,
 // along with a unique request id.
-func ListOrdersBatchRequest(token, locationID string, limit int, order string) (*BatchRequest, string) {
+//
+// Deprecated: use ListOrdersWithOptionsBatchRequest, which takes typed filters.
+func (c *Client) ListOrdersBatchRequest(token, locationID string, limit int, order string) (*BatchRequest, string) {
 	v := make([]*Order, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/orders?limit=%d&order=%s", locationID, limit, order), token, nil, &v)
+	return c.newBatchRequest("ListOrders", "GET", listOrdersPath(locationID, limit, order), token, nil, &v)
+}
+
+// ListOrdersWithOptionsBatchRequest returns a BatchRequest object for
+// ListOrdersWithOptions, along with a unique request id.
+func (c *Client) ListOrdersWithOptionsBatchRequest(token, locationID string, opts *ListOrdersOptions) (*BatchRequest, string) {
+	v := make([]*Order, 0)
+	return c.newBatchRequest("ListOrders", "GET", opts.path(locationID), token, nil, &v)
+}
+
+// ListOrdersWithOptionsBatchCall is like ListOrdersWithOptionsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListOrdersWithOptionsBatchCall(token, locationID string, opts *ListOrdersOptions) *BatchCall[[]*Order] {
+	br, _ := c.ListOrdersWithOptionsBatchRequest(token, locationID, opts)
+	return &BatchCall[[]*Order]{br}
 }
 
 // RetrieveOrderBatchRequest returns a BatchRequest object for RetrieveOrder,
 // along with a unique request id.
-func RetrieveOrderBatchRequest(token, locationID, orderID string) (*BatchRequest, string) {
+func (c *Client) RetrieveOrderBatchRequest(token, locationID, orderID string) (*BatchRequest, string) {
 	v := new(Order)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/orders/%s", locationID, orderID), token, nil, v)
+	return c.newBatchRequest("RetrieveOrder", "GET", fmt.Sprintf("/v1/%s/orders/%s", locationID, orderID), token, nil, v)
+}
+
+// RetrieveOrderBatchCall is like RetrieveOrderBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveOrderBatchCall(token, locationID, orderID string) *BatchCall[*Order] {
+	br, _ := c.RetrieveOrderBatchRequest(token, locationID, orderID)
+	return &BatchCall[*Order]{br}
 }
 
 // UpdateOrderBatchRequest returns a BatchRequest object for UpdateOrder,
 // along with a unique request id.
-func UpdateOrderBatchRequest(token, locationID, orderID string, reqObj *UpdateOrderReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateOrderBatchRequest(token, locationID, orderID string, reqObj *UpdateOrderReqObject) (*BatchRequest, string) {
 	v := new(Order)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/orders/%s", locationID, orderID), token, reqObj, v)
+	return c.newBatchRequest("UpdateOrder", "PUT", fmt.Sprintf("/v1/%s/orders/%s", locationID, orderID), token, reqObj, v)
+}
+
+// UpdateOrderBatchCall is like UpdateOrderBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateOrderBatchCall(token, locationID, orderID string, reqObj *UpdateOrderReqObject) *BatchCall[*Order] {
+	br, _ := c.UpdateOrderBatchRequest(token, locationID, orderID, reqObj)
+	return &BatchCall[*Order]{br}
 }
 
 // ListBankAccountsBatchRequest returns a BatchRequest object for ListBankAccounts,
 // along with a unique request id.
-func ListBankAccountsBatchRequest(token, locationID string) (*BatchRequest, string) {
+func (c *Client) ListBankAccountsBatchRequest(token, locationID string) (*BatchRequest, string) {
 	v := make([]*BankAccount, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/bank-accounts", locationID), token, nil, &v)
+	return c.newBatchRequest("ListBankAccounts", "GET", fmt.Sprintf("/v1/%s/bank-accounts", locationID), token, nil, &v)
+}
+
+// ListBankAccountsBatchCall is like ListBankAccountsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListBankAccountsBatchCall(token, locationID string) *BatchCall[[]*BankAccount] {
+	br, _ := c.ListBankAccountsBatchRequest(token, locationID)
+	return &BatchCall[[]*BankAccount]{br}
 }
 
 // RetrieveBankAccountBatchRequest returns a BatchRequest object for RetrieveBankAccount,
 // along with a unique request id.
-func RetrieveBankAccountBatchRequest(token, locationID, bankAccountID string) (*BatchRequest, string) {
+func (c *Client) RetrieveBankAccountBatchRequest(token, locationID, bankAccountID string) (*BatchRequest, string) {
 	v := new(BankAccount)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/bank-accounts/%s", locationID, bankAccountID), token, nil, v)
+	return c.newBatchRequest("RetrieveBankAccount", "GET", fmt.Sprintf("/v1/%s/bank-accounts/%s", locationID, bankAccountID), token, nil, v)
+}
+
+// RetrieveBankAccountBatchCall is like RetrieveBankAccountBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveBankAccountBatchCall(token, locationID, bankAccountID string) *BatchCall[*BankAccount] {
+	br, _ := c.RetrieveBankAccountBatchRequest(token, locationID, bankAccountID)
+	return &BatchCall[*BankAccount]{br}
 }
 
 // CreateItemBatchRequest returns a BatchRequest object for CreateItem,
 // along with a unique request id.
-func CreateItemBatchRequest(token, locationID string, reqObj *CreateItemReqObject) (*BatchRequest, string) {
+func (c *Client) CreateItemBatchRequest(token, locationID string, reqObj *CreateItemReqObject) (*BatchRequest, string) {
 	v := new(Item)
-	return newBatchRequest("POST", fmt.Sprintf("/v1/%s/items", locationID), token, reqObj, v)
+	return c.newBatchRequest("CreateItem", "POST", fmt.Sprintf("/v1/%s/items", locationID), token, reqObj, v)
+}
+
+// CreateItemBatchCall is like CreateItemBatchRequest but returns a typed BatchCall.
+func (c *Client) CreateItemBatchCall(token, locationID string, reqObj *CreateItemReqObject) *BatchCall[*Item] {
+	br, _ := c.CreateItemBatchRequest(token, locationID, reqObj)
+	return &BatchCall[*Item]{br}
 }
 
 // ListItemsBatchRequest returns a BatchRequest object for ListItems,
 // along with a unique request id.
-func ListItemsBatchRequest(token, locationID string) (*BatchRequest, string) {
+func (c *Client) ListItemsBatchRequest(token, locationID string) (*BatchRequest, string) {
 	v := make([]*Item, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/items", locationID), token, nil, &v)
+	return c.newBatchRequest("ListItems", "GET", fmt.Sprintf("/v1/%s/items", locationID), token, nil, &v)
+}
+
+// ListItemsBatchCall is like ListItemsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListItemsBatchCall(token, locationID string) *BatchCall[[]*Item] {
+	br, _ := c.ListItemsBatchRequest(token, locationID)
+	return &BatchCall[[]*Item]{br}
 }
 
 // RetrieveItemBatchRequest returns a BatchRequest object for RetrieveItem,
 // along with a unique request id.
-func RetrieveItemBatchRequest(token, locationID, itemID string) (*BatchRequest, string) {
+func (c *Client) RetrieveItemBatchRequest(token, locationID, itemID string) (*BatchRequest, string) {
 	v := new(Item)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, nil, v)
+	return c.newBatchRequest("RetrieveItem", "GET", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, nil, v)
+}
+
+// RetrieveItemBatchCall is like RetrieveItemBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveItemBatchCall(token, locationID, itemID string) *BatchCall[*Item] {
+	br, _ := c.RetrieveItemBatchRequest(token, locationID, itemID)
+	return &BatchCall[*Item]{br}
 }
 
 // UpdateItemBatchRequest returns a BatchRequest object for UpdateItem,
 // along with a unique request id.
-func UpdateItemBatchRequest(token, locationID, itemID string, reqObj *UpdateItemReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateItemBatchRequest(token, locationID, itemID string, reqObj *UpdateItemReqObject) (*BatchRequest, string) {
 	v := new(Item)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, reqObj, v)
+	return c.newBatchRequest("UpdateItem", "PUT", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, reqObj, v)
+}
+
+// UpdateItemBatchCall is like UpdateItemBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateItemBatchCall(token, locationID, itemID string, reqObj *UpdateItemReqObject) *BatchCall[*Item] {
+	br, _ := c.UpdateItemBatchRequest(token, locationID, itemID, reqObj)
+	return &BatchCall[*Item]{br}
 }
 
 // DeleteItemBatchRequest returns a BatchRequest object for DeleteItem,
 // along with a unique request id.
-func DeleteItemBatchRequest(token, locationID, itemID string) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, nil, nil)
+func (c *Client) DeleteItemBatchRequest(token, locationID, itemID string) (*BatchRequest, string) {
+	return c.newBatchRequest("DeleteItem", "DELETE", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, nil, nil)
+}
+
+// DeleteItemBatchCall is like DeleteItemBatchRequest but returns a typed BatchCall.
+func (c *Client) DeleteItemBatchCall(token, locationID, itemID string) *BatchCall[struct{}] {
+	br, _ := c.DeleteItemBatchRequest(token, locationID, itemID)
+	return &BatchCall[struct{}]{br}
+}
+
+// CreateVariationBatchRequest returns a BatchRequest object for CreateVariation,
+// along with a unique request id.
+func (c *Client) CreateVariationBatchRequest(token, locationID, itemID string, reqObj *CreateVariationReqObject) (*BatchRequest, string) {
+	v := new(ItemVariation)
+	return c.newBatchRequest("CreateVariation", "POST", fmt.Sprintf("/v1/%s/items/%s/variations", locationID, itemID), token, reqObj, v)
+}
+
+// CreateVariationBatchCall is like CreateVariationBatchRequest but returns a typed BatchCall.
+func (c *Client) CreateVariationBatchCall(token, locationID, itemID string, reqObj *CreateVariationReqObject) *BatchCall[*ItemVariation] {
+	br, _ := c.CreateVariationBatchRequest(token, locationID, itemID, reqObj)
+	return &BatchCall[*ItemVariation]{br}
 }
 
 // UpdateVariationBatchRequest returns a BatchRequest object for UpdateVariation,
 // along with a unique request id.
-func UpdateVariationBatchRequest(token, locationID, itemID, variationID string, reqObj *UpdateVariationReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateVariationBatchRequest(token, locationID, itemID, variationID string, reqObj *UpdateVariationReqObject) (*BatchRequest, string) {
 	v := new(ItemVariation)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/items/%s/variations/%s", locationID, itemID, variationID), token, reqObj, v)
+	return c.newBatchRequest("UpdateVariation", "PUT", fmt.Sprintf("/v1/%s/items/%s/variations/%s", locationID, itemID, variationID), token, reqObj, v)
+}
+
+// UpdateVariationBatchCall is like UpdateVariationBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateVariationBatchCall(token, locationID, itemID, variationID string, reqObj *UpdateVariationReqObject) *BatchCall[*ItemVariation] {
+	br, _ := c.UpdateVariationBatchRequest(token, locationID, itemID, variationID, reqObj)
+	return &BatchCall[*ItemVariation]{br}
 }
 
 // DeleteVariationBatchRequest returns a BatchRequest object for DeleteVariation,
 // along with a unique request id.
-func DeleteVariationBatchRequest(token, locationID, itemID, variationID string) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/%s/items/%s/variations/%s", locationID, itemID, variationID), token, nil, nil)
+func (c *Client) DeleteVariationBatchRequest(token, locationID, itemID, variationID string) (*BatchRequest, string) {
+	return c.newBatchRequest("DeleteVariation", "DELETE", fmt.Sprintf("/v1/%s/items/%s/variations/%s", locationID, itemID, variationID), token, nil, nil)
+}
+
+// DeleteVariationBatchCall is like DeleteVariationBatchRequest but returns a typed BatchCall.
+func (c *Client) DeleteVariationBatchCall(token, locationID, itemID, variationID string) *BatchCall[struct{}] {
+	br, _ := c.DeleteVariationBatchRequest(token, locationID, itemID, variationID)
+	return &BatchCall[struct{}]{br}
 }
 
 // ListInventoryBatchRequest returns a BatchRequest object for ListInventory,
 // along with a unique request id.
-func ListInventoryBatchRequest(token, locationID string, limit int) (*BatchRequest, string) {
+func (c *Client) ListInventoryBatchRequest(token, locationID string, limit int) (*BatchRequest, string) {
 	v := make([]*InventoryEntry, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/inventory?limit=%d", locationID, limit), token, nil, &v)
+	return c.newBatchRequest("ListInventory", "GET", listInventoryPath(locationID, limit), token, nil, &v)
+}
+
+// ListInventoryBatchCall is like ListInventoryBatchRequest but returns a typed BatchCall.
+func (c *Client) ListInventoryBatchCall(token, locationID string, limit int) *BatchCall[[]*InventoryEntry] {
+	br, _ := c.ListInventoryBatchRequest(token, locationID, limit)
+	return &BatchCall[[]*InventoryEntry]{br}
 }
 
 // AdjustInventoryBatchRequest returns a BatchRequest object for AdjustInventory,
 // along with a unique request id.
-func AdjustInventoryBatchRequest(token, locationID, variationID string, reqObj *AdjustInventoryReqObject) (*BatchRequest, string) {
+func (c *Client) AdjustInventoryBatchRequest(token, locationID, variationID string, reqObj *AdjustInventoryReqObject) (*BatchRequest, string) {
 	v := new(InventoryEntry)
-	return newBatchRequest("POST", fmt.Sprintf("/v1/%s/inventory/%s", locationID, variationID), token, reqObj, v)
+	return c.newBatchRequest("AdjustInventory", "POST", fmt.Sprintf("/v1/%s/inventory/%s", locationID, variationID), token, reqObj, v)
+}
+
+// AdjustInventoryBatchCall is like AdjustInventoryBatchRequest but returns a typed BatchCall.
+func (c *Client) AdjustInventoryBatchCall(token, locationID, variationID string, reqObj *AdjustInventoryReqObject) *BatchCall[*InventoryEntry] {
+	br, _ := c.AdjustInventoryBatchRequest(token, locationID, variationID, reqObj)
+	return &BatchCall[*InventoryEntry]{br}
 }
 
 // CreateModifierListBatchRequest returns a BatchRequest object for CreateModifierList,
 // along with a unique request id.
-func CreateModifierListBatchRequest(token, locationID string, reqObj *CreateModifierListReqObject) (*BatchRequest, string) {
+func (c *Client) CreateModifierListBatchRequest(token, locationID string, reqObj *CreateModifierListReqObject) (*BatchRequest, string) {
 	v := new(ModifierList)
-	return newBatchRequest("POST", fmt.Sprintf("/v1/%s/modifier-lists", locationID), token, reqObj, v)
+	return c.newBatchRequest("CreateModifierList", "POST", fmt.Sprintf("/v1/%s/modifier-lists", locationID), token, reqObj, v)
+}
+
+// CreateModifierListBatchCall is like CreateModifierListBatchRequest but returns a typed BatchCall.
+func (c *Client) CreateModifierListBatchCall(token, locationID string, reqObj *CreateModifierListReqObject) *BatchCall[*ModifierList] {
+	br, _ := c.CreateModifierListBatchRequest(token, locationID, reqObj)
+	return &BatchCall[*ModifierList]{br}
 }
 
 // ListModifierListsBatchRequest returns a BatchRequest object for ListModifierLists,
 // along with a unique request id.
-func ListModifierListsBatchRequest(token, locationID string) (*BatchRequest, string) {
+func (c *Client) ListModifierListsBatchRequest(token, locationID string) (*BatchRequest, string) {
 	v := make([]*ModifierList, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/modifier-lists", locationID), token, nil, &v)
+	return c.newBatchRequest("ListModifierLists", "GET", fmt.Sprintf("/v1/%s/modifier-lists", locationID), token, nil, &v)
+}
+
+// ListModifierListsBatchCall is like ListModifierListsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListModifierListsBatchCall(token, locationID string) *BatchCall[[]*ModifierList] {
+	br, _ := c.ListModifierListsBatchRequest(token, locationID)
+	return &BatchCall[[]*ModifierList]{br}
 }
 
 // RetrieveModifierListBatchRequest returns a BatchRequest object for RetrieveModifierList,
 // along with a unique request id.
-func RetrieveModifierListBatchRequest(token, locationID, modifierListID string) (*BatchRequest, string) {
+func (c *Client) RetrieveModifierListBatchRequest(token, locationID, modifierListID string) (*BatchRequest, string) {
 	v := new(ModifierList)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, nil, v)
+	return c.newBatchRequest("RetrieveModifierList", "GET", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, nil, v)
+}
+
+// RetrieveModifierListBatchCall is like RetrieveModifierListBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveModifierListBatchCall(token, locationID, modifierListID string) *BatchCall[*ModifierList] {
+	br, _ := c.RetrieveModifierListBatchRequest(token, locationID, modifierListID)
+	return &BatchCall[*ModifierList]{br}
 }
 
 // UpdateModifierListBatchRequest returns a BatchRequest object for UpdateModifierList,
 // along with a unique request id.
-func UpdateModifierListBatchRequest(token, locationID, modifierListID string, reqObj *UpdateModifierListReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateModifierListBatchRequest(token, locationID, modifierListID string, reqObj *UpdateModifierListReqObject) (*BatchRequest, string) {
 	v := new(ModifierList)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, reqObj, v)
+	return c.newBatchRequest("UpdateModifierList", "PUT", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, reqObj, v)
+}
+
+// UpdateModifierListBatchCall is like UpdateModifierListBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateModifierListBatchCall(token, locationID, modifierListID string, reqObj *UpdateModifierListReqObject) *BatchCall[*ModifierList] {
+	br, _ := c.UpdateModifierListBatchRequest(token, locationID, modifierListID, reqObj)
+	return &BatchCall[*ModifierList]{br}
 }
 
 // DeleteModifierListBatchRequest returns a BatchRequest object for DeleteModifierList,
 // along with a unique request id.
-func DeleteModifierListBatchRequest(token, locationID, modifierListID string) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, nil, nil)
+func (c *Client) DeleteModifierListBatchRequest(token, locationID, modifierListID string) (*BatchRequest, string) {
+	return c.newBatchRequest("DeleteModifierList", "DELETE", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, nil, nil)
+}
+
+// DeleteModifierListBatchCall is like DeleteModifierListBatchRequest but returns a typed BatchCall.
+func (c *Client) DeleteModifierListBatchCall(token, locationID, modifierListID string) *BatchCall[struct{}] {
+	br, _ := c.DeleteModifierListBatchRequest(token, locationID, modifierListID)
+	return &BatchCall[struct{}]{br}
 }
 
 // ApplyModifierListBatchRequest returns a BatchRequest object for ApplyModifierList,
 // along with a unique request id.
-func ApplyModifierListBatchRequest(token, locationID, itemID, modifierListID string) (*BatchRequest, string) {
+func (c *Client) ApplyModifierListBatchRequest(token, locationID, itemID, modifierListID string) (*BatchRequest, string) {
 	v := new(Item)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/items/%s/modifier-lists/%s", locationID, itemID, modifierListID), token, nil, v)
+	return c.newBatchRequest("ApplyModifierList", "PUT", fmt.Sprintf("/v1/%s/items/%s/modifier-lists/%s", locationID, itemID, modifierListID), token, nil, v)
+}
+
+// ApplyModifierListBatchCall is like ApplyModifierListBatchRequest but returns a typed BatchCall.
+func (c *Client) ApplyModifierListBatchCall(token, locationID, itemID, modifierListID string) *BatchCall[*Item] {
+	br, _ := c.ApplyModifierListBatchRequest(token, locationID, itemID, modifierListID)
+	return &BatchCall[*Item]{br}
 }
 
 // RemoveModifierListBatchRequest returns a BatchRequest object for RemoveModifierList,
 // along with a unique request id.
-func RemoveModifierListBatchRequest(token, locationID, itemID, modifierListID string) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/%s/items/%s/modifier-lists/%s", locationID, itemID, modifierListID), token, nil, nil)
+func (c *Client) RemoveModifierListBatchRequest(token, locationID, itemID, modifierListID string) (*BatchRequest, string) {
+	return c.newBatchRequest("RemoveModifierList", "DELETE", fmt.Sprintf("/v1/%s/items/%s/modifier-lists/%s", locationID, itemID, modifierListID), token, nil, nil)
+}
+
+// RemoveModifierListBatchCall is like RemoveModifierListBatchRequest but returns a typed BatchCall.
+func (c *Client) RemoveModifierListBatchCall(token, locationID, itemID, modifierListID string) *BatchCall[struct{}] {
+	br, _ := c.RemoveModifierListBatchRequest(token, locationID, itemID, modifierListID)
+	return &BatchCall[struct{}]{br}
 }
 
 // CreateModifierOptionBatchRequest returns a BatchRequest object for CreateModifierOption,
 // along with a unique request id.
-func CreateModifierOptionBatchRequest(token, locationID, modifierListID string, reqObj *CreateModifierOptionReqObject) (*BatchRequest, string) {
+func (c *Client) CreateModifierOptionBatchRequest(token, locationID, modifierListID string, reqObj *CreateModifierOptionReqObject) (*BatchRequest, string) {
 	v := new(ModifierOption)
-	return newBatchRequest("POST", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options", locationID, modifierListID), token, reqObj, v)
+	return c.newBatchRequest("CreateModifierOption", "POST", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options", locationID, modifierListID), token, reqObj, v)
+}
+
+// CreateModifierOptionBatchCall is like CreateModifierOptionBatchRequest but returns a typed BatchCall.
+func (c *Client) CreateModifierOptionBatchCall(token, locationID, modifierListID string, reqObj *CreateModifierOptionReqObject) *BatchCall[*ModifierOption] {
+	br, _ := c.CreateModifierOptionBatchRequest(token, locationID, modifierListID, reqObj)
+	return &BatchCall[*ModifierOption]{br}
 }
 
 // UpdateModifierOptionBatchRequest returns a BatchRequest object for UpdateModifierOption,
 // along with a unique request id.
-func UpdateModifierOptionBatchRequest(token, locationID, modifierListID, modifierOptionID string, reqObj *UpdateModifierOptionReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateModifierOptionBatchRequest(token, locationID, modifierListID, modifierOptionID string, reqObj *UpdateModifierOptionReqObject) (*BatchRequest, string) {
 	v := new(ModifierOption)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options/%s", locationID, modifierListID, modifierOptionID), token, reqObj, v)
+	return c.newBatchRequest("UpdateModifierOption", "PUT", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options/%s", locationID, modifierListID, modifierOptionID), token, reqObj, v)
+}
+
+// UpdateModifierOptionBatchCall is like UpdateModifierOptionBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateModifierOptionBatchCall(token, locationID, modifierListID, modifierOptionID string, reqObj *UpdateModifierOptionReqObject) *BatchCall[*ModifierOption] {
+	br, _ := c.UpdateModifierOptionBatchRequest(token, locationID, modifierListID, modifierOptionID, reqObj)
+	return &BatchCall[*ModifierOption]{br}
 }
 
 // DeleteModifierOptionBatchRequest returns a BatchRequest object for DeleteModifierOption,
 // along with a unique request id.
-func DeleteModifierOptionBatchRequest(token, locationID, modifierListID, modifierOptionID string) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options/%s", locationID, modifierListID, modifierOptionID), token, nil, nil)
+func (c *Client) DeleteModifierOptionBatchRequest(token, locationID, modifierListID, modifierOptionID string) (*BatchRequest, string) {
+	return c.newBatchRequest("DeleteModifierOption", "DELETE", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options/%s", locationID, modifierListID, modifierOptionID), token, nil, nil)
+}
+
+// DeleteModifierOptionBatchCall is like DeleteModifierOptionBatchRequest but returns a typed BatchCall.
+func (c *Client) DeleteModifierOptionBatchCall(token, locationID, modifierListID, modifierOptionID string) *BatchCall[struct{}] {
+	br, _ := c.DeleteModifierOptionBatchRequest(token, locationID, modifierListID, modifierOptionID)
+	return &BatchCall[struct{}]{br}
 }
 
 // CreateCategoryBatchRequest returns a BatchRequest object for CreateCategory,
 // along with a unique request id.
-func CreateCategoryBatchRequest(token, locationID string, reqObj *CreateCategoryReqObject) (*BatchRequest, string) {
+func (c *Client) CreateCategoryBatchRequest(token, locationID string, reqObj *CreateCategoryReqObject) (*BatchRequest, string) {
 	v := new(Category)
-	return newBatchRequest("POST", fmt.Sprintf("/v1/%s/categories", locationID), token, reqObj, v)
+	return c.newBatchRequest("CreateCategory", "POST", fmt.Sprintf("/v1/%s/categories", locationID), token, reqObj, v)
+}
+
+// CreateCategoryBatchCall is like CreateCategoryBatchRequest but returns a typed BatchCall.
+func (c *Client) CreateCategoryBatchCall(token, locationID string, reqObj *CreateCategoryReqObject) *BatchCall[*Category] {
+	br, _ := c.CreateCategoryBatchRequest(token, locationID, reqObj)
+	return &BatchCall[*Category]{br}
 }
 
 // ListCategoriesBatchRequest returns a BatchRequest object for ListCategories,
 // along with a unique request id.
-func ListCategoriesBatchRequest(token, locationID string) (*BatchRequest, string) {
+func (c *Client) ListCategoriesBatchRequest(token, locationID string) (*BatchRequest, string) {
 	v := make([]*Category, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/categories", locationID), token, nil, &v)
+	return c.newBatchRequest("ListCategories", "GET", fmt.Sprintf("/v1/%s/categories", locationID), token, nil, &v)
+}
+
+// ListCategoriesBatchCall is like ListCategoriesBatchRequest but returns a typed BatchCall.
+func (c *Client) ListCategoriesBatchCall(token, locationID string) *BatchCall[[]*Category] {
+	br, _ := c.ListCategoriesBatchRequest(token, locationID)
+	return &BatchCall[[]*Category]{br}
 }
 
 // UpdateCategoryBatchRequest returns a BatchRequest object for UpdateCategory,
 // along with a unique request id.
-func UpdateCategoryBatchRequest(token, locationID, categoryID string, reqObj *UpdateCategoryReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateCategoryBatchRequest(token, locationID, categoryID string, reqObj *UpdateCategoryReqObject) (*BatchRequest, string) {
 	v := new(Category)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/categories/%s", locationID, categoryID), token, reqObj, v)
+	return c.newBatchRequest("UpdateCategory", "PUT", fmt.Sprintf("/v1/%s/categories/%s", locationID, categoryID), token, reqObj, v)
+}
+
+// UpdateCategoryBatchCall is like UpdateCategoryBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateCategoryBatchCall(token, locationID, categoryID string, reqObj *UpdateCategoryReqObject) *BatchCall[*Category] {
+	br, _ := c.UpdateCategoryBatchRequest(token, locationID, categoryID, reqObj)
+	return &BatchCall[*Category]{br}
 }
 
 // DeleteCategoryBatchRequest returns a BatchRequest object for DeleteCategory,
 // along with a unique request id.
-func DeleteCategoryBatchRequest(token, locationID, categoryID string) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/%s/categories/%s", locationID, categoryID), token, nil, nil)
+func (c *Client) DeleteCategoryBatchRequest(token, locationID, categoryID string) (*BatchRequest, string) {
+	return c.newBatchRequest("DeleteCategory", "DELETE", fmt.Sprintf("/v1/%s/categories/%s", locationID, categoryID), token, nil, nil)
+}
+
+// DeleteCategoryBatchCall is like DeleteCategoryBatchRequest but returns a typed BatchCall.
+func (c *Client) DeleteCategoryBatchCall(token, locationID, categoryID string) *BatchCall[struct{}] {
+	br, _ := c.DeleteCategoryBatchRequest(token, locationID, categoryID)
+	return &BatchCall[struct{}]{br}
 }
 
 // CreateDiscountBatchRequest returns a BatchRequest object for CreateDiscount,
 // along with a unique request id.
-func CreateDiscountBatchRequest(token, locationID string, reqObj *CreateDiscountReqObject) (*BatchRequest, string) {
+func (c *Client) CreateDiscountBatchRequest(token, locationID string, reqObj *CreateDiscountReqObject) (*BatchRequest, string) {
 	v := new(Discount)
-	return newBatchRequest("POST", fmt.Sprintf("/v1/%s/discounts", locationID), token, reqObj, v)
+	return c.newBatchRequest("CreateDiscount", "POST", fmt.Sprintf("/v1/%s/discounts", locationID), token, reqObj, v)
+}
+
+// CreateDiscountBatchCall is like CreateDiscountBatchRequest but returns a typed BatchCall.
+func (c *Client) CreateDiscountBatchCall(token, locationID string, reqObj *CreateDiscountReqObject) *BatchCall[*Discount] {
+	br, _ := c.CreateDiscountBatchRequest(token, locationID, reqObj)
+	return &BatchCall[*Discount]{br}
 }
 
 // ListDiscountsBatchRequest returns a BatchRequest object for ListDiscounts,
 // along with a unique request id.
-func ListDiscountsBatchRequest(token, locationID string) (*BatchRequest, string) {
+func (c *Client) ListDiscountsBatchRequest(token, locationID string) (*BatchRequest, string) {
 	v := make([]*Discount, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/discounts", locationID), token, nil, &v)
+	return c.newBatchRequest("ListDiscounts", "GET", fmt.Sprintf("/v1/%s/discounts", locationID), token, nil, &v)
+}
+
+// ListDiscountsBatchCall is like ListDiscountsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListDiscountsBatchCall(token, locationID string) *BatchCall[[]*Discount] {
+	br, _ := c.ListDiscountsBatchRequest(token, locationID)
+	return &BatchCall[[]*Discount]{br}
 }
 
 // UpdateDiscountBatchRequest returns a BatchRequest object for UpdateDiscount,
 // along with a unique request id.
-func UpdateDiscountBatchRequest(token, locationID, discountID string, reqObj *UpdateDiscountReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateDiscountBatchRequest(token, locationID, discountID string, reqObj *UpdateDiscountReqObject) (*BatchRequest, string) {
 	v := new(Discount)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/discounts/%s", locationID, discountID), token, reqObj, v)
+	return c.newBatchRequest("UpdateDiscount", "PUT", fmt.Sprintf("/v1/%s/discounts/%s", locationID, discountID), token, reqObj, v)
+}
+
+// UpdateDiscountBatchCall is like UpdateDiscountBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateDiscountBatchCall(token, locationID, discountID string, reqObj *UpdateDiscountReqObject) *BatchCall[*Discount] {
+	br, _ := c.UpdateDiscountBatchRequest(token, locationID, discountID, reqObj)
+	return &BatchCall[*Discount]{br}
 }
 
 // DeleteDiscountBatchRequest returns a BatchRequest object for DeleteDiscount,
 // along with a unique request id.
-func DeleteDiscountBatchRequest(token, locationID, discountID string) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/%s/discounts/%s", locationID, discountID), token, nil, nil)
+func (c *Client) DeleteDiscountBatchRequest(token, locationID, discountID string) (*BatchRequest, string) {
+	return c.newBatchRequest("DeleteDiscount", "DELETE", fmt.Sprintf("/v1/%s/discounts/%s", locationID, discountID), token, nil, nil)
+}
+
+// DeleteDiscountBatchCall is like DeleteDiscountBatchRequest but returns a typed BatchCall.
+func (c *Client) DeleteDiscountBatchCall(token, locationID, discountID string) *BatchCall[struct{}] {
+	br, _ := c.DeleteDiscountBatchRequest(token, locationID, discountID)
+	return &BatchCall[struct{}]{br}
 }
 
 // CreateFeeBatchRequest returns a BatchRequest object for CreateFee,
 // along with a unique request id.
-func CreateFeeBatchRequest(token, locationID string, reqObj *CreateFeeReqObject) (*BatchRequest, string) {
+func (c *Client) CreateFeeBatchRequest(token, locationID string, reqObj *CreateFeeReqObject) (*BatchRequest, string) {
 	v := new(Fee)
-	return newBatchRequest("POST", fmt.Sprintf("/v1/%s/fees", locationID), token, reqObj, v)
+	return c.newBatchRequest("CreateFee", "POST", fmt.Sprintf("/v1/%s/fees", locationID), token, reqObj, v)
+}
+
+// CreateFeeBatchCall is like CreateFeeBatchRequest but returns a typed BatchCall.
+func (c *Client) CreateFeeBatchCall(token, locationID string, reqObj *CreateFeeReqObject) *BatchCall[*Fee] {
+	br, _ := c.CreateFeeBatchRequest(token, locationID, reqObj)
+	return &BatchCall[*Fee]{br}
 }
 
 // ListFeesBatchRequest returns a BatchRequest object for ListFees,
 // along with a unique request id.
-func ListFeesBatchRequest(token, locationID string) (*BatchRequest, string) {
+func (c *Client) ListFeesBatchRequest(token, locationID string) (*BatchRequest, string) {
 	v := make([]*Fee, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/fees", locationID), token, nil, &v)
+	return c.newBatchRequest("ListFees", "GET", fmt.Sprintf("/v1/%s/fees", locationID), token, nil, &v)
+}
+
+// ListFeesBatchCall is like ListFeesBatchRequest but returns a typed BatchCall.
+func (c *Client) ListFeesBatchCall(token, locationID string) *BatchCall[[]*Fee] {
+	br, _ := c.ListFeesBatchRequest(token, locationID)
+	return &BatchCall[[]*Fee]{br}
 }
 
 // UpdateFeeBatchRequest returns a BatchRequest object for UpdateFee,
 // along with a unique request id.
-func UpdateFeeBatchRequest(token, locationID, feeID string, reqObj *UpdateFeeReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateFeeBatchRequest(token, locationID, feeID string, reqObj *UpdateFeeReqObject) (*BatchRequest, string) {
 	v := new(Fee)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/fees/%s", locationID, feeID), token, reqObj, v)
+	return c.newBatchRequest("UpdateFee", "PUT", fmt.Sprintf("/v1/%s/fees/%s", locationID, feeID), token, reqObj, v)
+}
+
+// UpdateFeeBatchCall is like UpdateFeeBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateFeeBatchCall(token, locationID, feeID string, reqObj *UpdateFeeReqObject) *BatchCall[*Fee] {
+	br, _ := c.UpdateFeeBatchRequest(token, locationID, feeID, reqObj)
+	return &BatchCall[*Fee]{br}
 }
 
 // DeleteFeeBatchRequest returns a BatchRequest object for DeleteFee,
 // along with a unique request id.
-func DeleteFeeBatchRequest(token, locationID, feeID string) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/%s/fees/%s", locationID, feeID), token, nil, nil)
+func (c *Client) DeleteFeeBatchRequest(token, locationID, feeID string) (*BatchRequest, string) {
+	return c.newBatchRequest("DeleteFee", "DELETE", fmt.Sprintf("/v1/%s/fees/%s", locationID, feeID), token, nil, nil)
+}
+
+// DeleteFeeBatchCall is like DeleteFeeBatchRequest but returns a typed BatchCall.
+func (c *Client) DeleteFeeBatchCall(token, locationID, feeID string) *BatchCall[struct{}] {
+	br, _ := c.DeleteFeeBatchRequest(token, locationID, feeID)
+	return &BatchCall[struct{}]{br}
 }
 
 // ApplyFeeBatchRequest returns a BatchRequest object for ApplyFee,
 // along with a unique request id.
-func ApplyFeeBatchRequest(token, locationID, itemID, feeID string) (*BatchRequest, string) {
+func (c *Client) ApplyFeeBatchRequest(token, locationID, itemID, feeID string) (*BatchRequest, string) {
 	v := new(Item)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/items/%s/fees/%s", locationID, itemID, feeID), token, nil, v)
+	return c.newBatchRequest("ApplyFee", "PUT", fmt.Sprintf("/v1/%s/items/%s/fees/%s", locationID, itemID, feeID), token, nil, v)
+}
+
+// ApplyFeeBatchCall is like ApplyFeeBatchRequest but returns a typed BatchCall.
+func (c *Client) ApplyFeeBatchCall(token, locationID, itemID, feeID string) *BatchCall[*Item] {
+	br, _ := c.ApplyFeeBatchRequest(token, locationID, itemID, feeID)
+	return &BatchCall[*Item]{br}
 }
 
 // RemoveFeeBatchRequest returns a BatchRequest object for RemoveFee,
 // along with a unique request id.
-func RemoveFeeBatchRequest(token, locationID, itemID, feeID string) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/%s/items/%s/fees/%s", locationID, itemID, feeID), token, nil, nil)
+func (c *Client) RemoveFeeBatchRequest(token, locationID, itemID, feeID string) (*BatchRequest, string) {
+	return c.newBatchRequest("RemoveFee", "DELETE", fmt.Sprintf("/v1/%s/items/%s/fees/%s", locationID, itemID, feeID), token, nil, nil)
+}
+
+// RemoveFeeBatchCall is like RemoveFeeBatchRequest but returns a typed BatchCall.
+func (c *Client) RemoveFeeBatchCall(token, locationID, itemID, feeID string) *BatchCall[struct{}] {
+	br, _ := c.RemoveFeeBatchRequest(token, locationID, itemID, feeID)
+	return &BatchCall[struct{}]{br}
 }
 
 // CreatePageBatchRequest returns a BatchRequest object for CreatePage,
 // along with a unique request id.
-func CreatePageBatchRequest(token, locationID string, reqObj *CreatePageReqObject) (*BatchRequest, string) {
+func (c *Client) CreatePageBatchRequest(token, locationID string, reqObj *CreatePageReqObject) (*BatchRequest, string) {
 	v := new(Page)
-	return newBatchRequest("POST", fmt.Sprintf("/v1/%s/pages", locationID), token, reqObj, v)
+	return c.newBatchRequest("CreatePage", "POST", fmt.Sprintf("/v1/%s/pages", locationID), token, reqObj, v)
+}
+
+// CreatePageBatchCall is like CreatePageBatchRequest but returns a typed BatchCall.
+func (c *Client) CreatePageBatchCall(token, locationID string, reqObj *CreatePageReqObject) *BatchCall[*Page] {
+	br, _ := c.CreatePageBatchRequest(token, locationID, reqObj)
+	return &BatchCall[*Page]{br}
 }
 
 // ListPagesBatchRequest returns a BatchRequest object for ListPages,
 // along with a unique request id.
-func ListPagesBatchRequest(token, locationID string) (*BatchRequest, string) {
+func (c *Client) ListPagesBatchRequest(token, locationID string) (*BatchRequest, string) {
 	v := make([]*Page, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/pages", locationID), token, nil, &v)
+	return c.newBatchRequest("ListPages", "GET", fmt.Sprintf("/v1/%s/pages", locationID), token, nil, &v)
+}
+
+// ListPagesBatchCall is like ListPagesBatchRequest but returns a typed BatchCall.
+func (c *Client) ListPagesBatchCall(token, locationID string) *BatchCall[[]*Page] {
+	br, _ := c.ListPagesBatchRequest(token, locationID)
+	return &BatchCall[[]*Page]{br}
 }
 
 // UpdatePageBatchRequest returns a BatchRequest object for UpdatePage,
 // along with a unique request id.
-func UpdatePageBatchRequest(token, locationID, pageID string, reqObj *UpdatePageReqObject) (*BatchRequest, string) {
+func (c *Client) UpdatePageBatchRequest(token, locationID, pageID string, reqObj *UpdatePageReqObject) (*BatchRequest, string) {
 	v := new(Page)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/pages/%s", locationID, pageID), token, reqObj, v)
+	return c.newBatchRequest("UpdatePage", "PUT", fmt.Sprintf("/v1/%s/pages/%s", locationID, pageID), token, reqObj, v)
+}
+
+// UpdatePageBatchCall is like UpdatePageBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdatePageBatchCall(token, locationID, pageID string, reqObj *UpdatePageReqObject) *BatchCall[*Page] {
+	br, _ := c.UpdatePageBatchRequest(token, locationID, pageID, reqObj)
+	return &BatchCall[*Page]{br}
 }
 
 // DeletePageBatchRequest returns a BatchRequest object for DeletePage,
 // along with a unique request id.
-func DeletePageBatchRequest(token, locationID, pageID string) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/%s/pages/%s", locationID, pageID), token, nil, nil)
+func (c *Client) DeletePageBatchRequest(token, locationID, pageID string) (*BatchRequest, string) {
+	return c.newBatchRequest("DeletePage", "DELETE", fmt.Sprintf("/v1/%s/pages/%s", locationID, pageID), token, nil, nil)
+}
+
+// DeletePageBatchCall is like DeletePageBatchRequest but returns a typed BatchCall.
+func (c *Client) DeletePageBatchCall(token, locationID, pageID string) *BatchCall[struct{}] {
+	br, _ := c.DeletePageBatchRequest(token, locationID, pageID)
+	return &BatchCall[struct{}]{br}
 }
 
 // UpdateCellBatchRequest returns a BatchRequest object for UpdateCell,
 // along with a unique request id.
-func UpdateCellBatchRequest(token, locationID, pageID string, reqObj *UpdateCellReqObject) (*BatchRequest, string) {
+func (c *Client) UpdateCellBatchRequest(token, locationID, pageID string, reqObj *UpdateCellReqObject) (*BatchRequest, string) {
 	v := new(PageCell)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/pages/%s/cells", locationID, pageID), token, reqObj, v)
+	return c.newBatchRequest("UpdateCell", "PUT", fmt.Sprintf("/v1/%s/pages/%s/cells", locationID, pageID), token, reqObj, v)
+}
+
+// UpdateCellBatchCall is like UpdateCellBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateCellBatchCall(token, locationID, pageID string, reqObj *UpdateCellReqObject) *BatchCall[*PageCell] {
+	br, _ := c.UpdateCellBatchRequest(token, locationID, pageID, reqObj)
+	return &BatchCall[*PageCell]{br}
 }
 
 // DeleteCellBatchRequest returns a BatchRequest object for DeleteCell,
 // along with a unique request id.
-func DeleteCellBatchRequest(token, locationID, pageID string, row, column int) (*BatchRequest, string) {
-	return newBatchRequest("DELETE", fmt.Sprintf("/v1/%s/pages/%s/cells?row=%d&column=%d", locationID, pageID, row, column), token, nil, nil)
+func (c *Client) DeleteCellBatchRequest(token, locationID, pageID string, row, column int) (*BatchRequest, string) {
+	return c.newBatchRequest("DeleteCell", "DELETE", deleteCellPath(locationID, pageID, row, column), token, nil, nil)
+}
+
+// DeleteCellBatchCall is like DeleteCellBatchRequest but returns a typed BatchCall.
+func (c *Client) DeleteCellBatchCall(token, locationID, pageID string, row, column int) *BatchCall[struct{}] {
+	br, _ := c.DeleteCellBatchRequest(token, locationID, pageID, row, column)
+	return &BatchCall[struct{}]{br}
 }
 
 // ListWebhooksBatchRequest returns a BatchRequest object for ListWebhooks,
 // along with a unique request id.
-func ListWebhooksBatchRequest(token, locationID string) (*BatchRequest, string) {
+func (c *Client) ListWebhooksBatchRequest(token, locationID string) (*BatchRequest, string) {
 	v := make([]string, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/v1/%s/webhooks", locationID), token, nil, &v)
+	return c.newBatchRequest("ListWebhooks", "GET", fmt.Sprintf("/v1/%s/webhooks", locationID), token, nil, &v)
+}
+
+// ListWebhooksBatchCall is like ListWebhooksBatchRequest but returns a typed BatchCall.
+func (c *Client) ListWebhooksBatchCall(token, locationID string) *BatchCall[[]string] {
+	br, _ := c.ListWebhooksBatchRequest(token, locationID)
+	return &BatchCall[[]string]{br}
 }
 
 // UpdateWebhooksBatchRequest returns a BatchRequest object for UpdateWebhooks,
 // along with a unique request id.
-func UpdateWebhooksBatchRequest(token, locationID string) (*BatchRequest, string) {
+//
+// Deprecated: use UpdateWebhooksWithEventTypesBatchRequest instead.
+func (c *Client) UpdateWebhooksBatchRequest(token, locationID string) (*BatchRequest, string) {
+	v := make([]string, 0)
+	return c.newBatchRequest("UpdateWebhooks", "PUT", fmt.Sprintf("/v1/%s/webhooks", locationID), token, nil, &v)
+}
+
+// UpdateWebhooksBatchCall is like UpdateWebhooksBatchRequest but returns a typed BatchCall.
+//
+// Deprecated: use UpdateWebhooksWithEventTypesBatchCall instead.
+func (c *Client) UpdateWebhooksBatchCall(token, locationID string) *BatchCall[[]string] {
+	br, _ := c.UpdateWebhooksBatchRequest(token, locationID)
+	return &BatchCall[[]string]{br}
+}
+
+// UpdateWebhooksWithEventTypesBatchRequest returns a BatchRequest object for UpdateWebhooksWithEventTypes,
+// along with a unique request id.
+func (c *Client) UpdateWebhooksWithEventTypesBatchRequest(token, locationID string, eventTypes []string) (*BatchRequest, string) {
 	v := make([]string, 0)
-	return newBatchRequest("PUT", fmt.Sprintf("/v1/%s/webhooks", locationID), token, nil, &v)
+	return c.newBatchRequest("UpdateWebhooks", "PUT", fmt.Sprintf("/v1/%s/webhooks", locationID), token, eventTypes, &v)
+}
+
+// UpdateWebhooksWithEventTypesBatchCall is like UpdateWebhooksWithEventTypesBatchRequest but returns a typed BatchCall.
+func (c *Client) UpdateWebhooksWithEventTypesBatchCall(token, locationID string, eventTypes []string) *BatchCall[[]string] {
+	br, _ := c.UpdateWebhooksWithEventTypesBatchRequest(token, locationID, eventTypes)
+	return &BatchCall[[]string]{br}
 }
 
 // ListSubscriptionsBatchRequest returns a BatchRequest object for ListSubscriptions,
 // along with a unique request id.
-func ListSubscriptionsBatchRequest(token, clientID, merchantID string, limit int) (*BatchRequest, string) {
+//
+// Deprecated: use ListSubscriptionsWithOptionsBatchRequest, which takes typed filters.
+func (c *Client) ListSubscriptionsBatchRequest(token, clientID, merchantID string, limit int) (*BatchRequest, string) {
+	v := make([]*Subscription, 0)
+	return c.newBatchRequest("ListSubscriptions", "GET", listSubscriptionsPath(clientID, merchantID, limit), token, nil, &v)
+}
+
+// ListSubscriptionsWithOptionsBatchRequest returns a BatchRequest object for
+// ListSubscriptionsWithOptions, along with a unique request id.
+func (c *Client) ListSubscriptionsWithOptionsBatchRequest(token, clientID string, opts *ListSubscriptionsOptions) (*BatchRequest, string) {
 	v := make([]*Subscription, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/oauth2/clients/%s/subscriptions?merchant_id=%s&limit=%d", clientID, merchantID, limit), token, nil, &v)
+	return c.newBatchRequest("ListSubscriptions", "GET", opts.path(clientID), token, nil, &v)
+}
+
+// ListSubscriptionsWithOptionsBatchCall is like ListSubscriptionsWithOptionsBatchRequest but returns a typed BatchCall.
+func (c *Client) ListSubscriptionsWithOptionsBatchCall(token, clientID string, opts *ListSubscriptionsOptions) *BatchCall[[]*Subscription] {
+	br, _ := c.ListSubscriptionsWithOptionsBatchRequest(token, clientID, opts)
+	return &BatchCall[[]*Subscription]{br}
 }
 
 // RetrieveSubscriptionBatchRequest returns a BatchRequest object for RetrieveSubscription,
 // along with a unique request id.
-func RetrieveSubscriptionBatchRequest(token, clientID, subscriptionID string) (*BatchRequest, string) {
+func (c *Client) RetrieveSubscriptionBatchRequest(token, clientID, subscriptionID string) (*BatchRequest, string) {
 	v := new(Subscription)
-	return newBatchRequest("GET", fmt.Sprintf("/oauth2/clients/%s/subscriptions/%s", clientID, subscriptionID), token, nil, v)
+	return c.newBatchRequest("RetrieveSubscription", "GET", fmt.Sprintf("/oauth2/clients/%s/subscriptions/%s", clientID, subscriptionID), token, nil, v)
+}
+
+// RetrieveSubscriptionBatchCall is like RetrieveSubscriptionBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveSubscriptionBatchCall(token, clientID, subscriptionID string) *BatchCall[*Subscription] {
+	br, _ := c.RetrieveSubscriptionBatchRequest(token, clientID, subscriptionID)
+	return &BatchCall[*Subscription]{br}
 }
 
 // ListSubscriptionPlansBatchRequest returns a BatchRequest object for ListSubscriptionPlans,
 // along with a unique request id.
-func ListSubscriptionPlansBatchRequest(token, clientID string) (*BatchRequest, string) {
+func (c *Client) ListSubscriptionPlansBatchRequest(token, clientID string) (*BatchRequest, string) {
 	v := make([]*SubscriptionPlan, 0)
-	return newBatchRequest("GET", fmt.Sprintf("/oauth2/clients/%s/plans", clientID), token, nil, &v)
+	return c.newBatchRequest("ListSubscriptionPlans", "GET", fmt.Sprintf("/oauth2/clients/%s/plans", clientID), token, nil, &v)
+}
+
+// ListSubscriptionPlansBatchCall is like ListSubscriptionPlansBatchRequest but returns a typed BatchCall.
+func (c *Client) ListSubscriptionPlansBatchCall(token, clientID string) *BatchCall[[]*SubscriptionPlan] {
+	br, _ := c.ListSubscriptionPlansBatchRequest(token, clientID)
+	return &BatchCall[[]*SubscriptionPlan]{br}
 }
 
 // RetrieveSubscriptionPlanBatchRequest returns a BatchRequest object for RetrieveSubscriptionPlan,
 // along with a unique request id.
-func RetrieveSubscriptionPlanBatchRequest(token, clientID, planID string) (*BatchRequest, string) {
+func (c *Client) RetrieveSubscriptionPlanBatchRequest(token, clientID, planID string) (*BatchRequest, string) {
 	v := new(SubscriptionPlan)
-	return newBatchRequest("GET", fmt.Sprintf("/oauth2/clients/%s/plans/%s", clientID, planID), token, nil, v)
+	return c.newBatchRequest("RetrieveSubscriptionPlan", "GET", fmt.Sprintf("/oauth2/clients/%s/plans/%s", clientID, planID), token, nil, v)
+}
+
+// RetrieveSubscriptionPlanBatchCall is like RetrieveSubscriptionPlanBatchRequest but returns a typed BatchCall.
+func (c *Client) RetrieveSubscriptionPlanBatchCall(token, clientID, planID string) *BatchCall[*SubscriptionPlan] {
+	br, _ := c.RetrieveSubscriptionPlanBatchRequest(token, clientID, planID)
+	return &BatchCall[*SubscriptionPlan]{br}
+}
+
+// newBatchResult returns a new result for a batch request of operation,
+// nil for the operations without one. ok is false for unknown operations.
+func newBatchResult(operation string) (result interface{}, ok bool) {
+	switch operation {
+	case "RetrieveBusiness":
+		return new(Merchant), true
+	case "ListLocations":
+		v := make([]*Merchant, 0)
+		return &v, true
+	case "CreateEmployee":
+		return new(Employee), true
+	case "ListEmployees":
+		v := make([]*Employee, 0)
+		return &v, true
+	case "RetrieveEmployee":
+		return new(Employee), true
+	case "UpdateEmployee":
+		return new(Employee), true
+	case "CreateRole":
+		return new(EmployeeRole), true
+	case "ListRoles":
+		v := make([]*EmployeeRole, 0)
+		return &v, true
+	case "RetrieveRole":
+		return new(EmployeeRole), true
+	case "UpdateRole":
+		return new(EmployeeRole), true
+	case "CreateTimecard":
+		return new(Timecard), true
+	case "ListTimecards":
+		v := make([]*Timecard, 0)
+		return &v, true
+	case "RetrieveTimecard":
+		return new(Timecard), true
+	case "UpdateTimecard":
+		return new(Timecard), true
+	case "DeleteTimecard":
+		return nil, true
+	case "ListTimecardEvents":
+		v := make([]*TimecardEvent, 0)
+		return &v, true
+	case "ListCashDrawerShifts":
+		v := make([]*CashDrawerShift, 0)
+		return &v, true
+	case "RetrieveCashDrawerShift":
+		return new(CashDrawerShift), true
+	case "ListPayments":
+		v := make([]*Payment, 0)
+		return &v, true
+	case "RetrievePayment":
+		return new(Payment), true
+	case "ListSettlements":
+		v := make([]*Settlement, 0)
+		return &v, true
+	case "RetrieveSettlement":
+		return new(Settlement), true
+	case "CreateRefund":
+		return new(Refund), true
+	case "ListRefunds":
+		v := make([]*Refund, 0)
+		return &v, true
+	case "ListOrders":
+		v := make([]*Order, 0)
+		return &v, true
+	case "RetrieveOrder":
+		return new(Order), true
+	case "UpdateOrder":
+		return new(Order), true
+	case "ListBankAccounts":
+		v := make([]*BankAccount, 0)
+		return &v, true
+	case "RetrieveBankAccount":
+		return new(BankAccount), true
+	case "CreateItem":
+		return new(Item), true
+	case "ListItems":
+		v := make([]*Item, 0)
+		return &v, true
+	case "RetrieveItem":
+		return new(Item), true
+	case "UpdateItem":
+		return new(Item), true
+	case "DeleteItem":
+		return nil, true
+	case "CreateVariation":
+		return new(ItemVariation), true
+	case "UpdateVariation":
+		return new(ItemVariation), true
+	case "DeleteVariation":
+		return nil, true
+	case "ListInventory":
+		v := make([]*InventoryEntry, 0)
+		return &v, true
+	case "AdjustInventory":
+		return new(InventoryEntry), true
+	case "CreateModifierList":
+		return new(ModifierList), true
+	case "ListModifierLists":
+		v := make([]*ModifierList, 0)
+		return &v, true
+	case "RetrieveModifierList":
+		return new(ModifierList), true
+	case "UpdateModifierList":
+		return new(ModifierList), true
+	case "DeleteModifierList":
+		return nil, true
+	case "ApplyModifierList":
+		return new(Item), true
+	case "RemoveModifierList":
+		return nil, true
+	case "CreateModifierOption":
+		return new(ModifierOption), true
+	case "UpdateModifierOption":
+		return new(ModifierOption), true
+	case "DeleteModifierOption":
+		return nil, true
+	case "CreateCategory":
+		return new(Category), true
+	case "ListCategories":
+		v := make([]*Category, 0)
+		return &v, true
+	case "UpdateCategory":
+		return new(Category), true
+	case "DeleteCategory":
+		return nil, true
+	case "CreateDiscount":
+		return new(Discount), true
+	case "ListDiscounts":
+		v := make([]*Discount, 0)
+		return &v, true
+	case "UpdateDiscount":
+		return new(Discount), true
+	case "DeleteDiscount":
+		return nil, true
+	case "CreateFee":
+		return new(Fee), true
+	case "ListFees":
+		v := make([]*Fee, 0)
+		return &v, true
+	case "UpdateFee":
+		return new(Fee), true
+	case "DeleteFee":
+		return nil, true
+	case "ApplyFee":
+		return new(Item), true
+	case "RemoveFee":
+		return nil, true
+	case "CreatePage":
+		return new(Page), true
+	case "ListPages":
+		v := make([]*Page, 0)
+		return &v, true
+	case "UpdatePage":
+		return new(Page), true
+	case "DeletePage":
+		return nil, true
+	case "UpdateCell":
+		return new(PageCell), true
+	case "DeleteCell":
+		return nil, true
+	case "ListWebhooks":
+		v := make([]string, 0)
+		return &v, true
+	case "UpdateWebhooks":
+		v := make([]string, 0)
+		return &v, true
+	case "ListSubscriptions":
+		v := make([]*Subscription, 0)
+		return &v, true
+	case "RetrieveSubscription":
+		return new(Subscription), true
+	case "ListSubscriptionPlans":
+		v := make([]*SubscriptionPlan, 0)
+		return &v, true
+	case "RetrieveSubscriptionPlan":
+		return new(SubscriptionPlan), true
+	}
+	return nil, false
 }
@@ -0,0 +1,244 @@
+package gosquare_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+)
+
+func seedPayments(srv *gosquaretest.Server, locationID string, n int) {
+	payments := make([]*gosquare.Payment, n)
+	for i := range payments {
+		payments[i] = &gosquare.Payment{
+			ID:         fmt.Sprintf("P%03d", i),
+			MerchantID: "MERCHANT_ID",
+			CreatedAt:  fmt.Sprintf("2026-01-01T00:%02d:%02dZ", i/60, i%60),
+		}
+	}
+	srv.SeedPayments(locationID, payments...)
+}
+
+func TestFollowBatchPagesFailedPage(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	srv.SetPageSize(2)
+	seedPayments(srv, "L", 5)
+	c := srv.Client()
+	batches := 0
+	c.Middleware = append(c.Middleware, func(next gosquare.Handler) gosquare.Handler {
+		return func(ctx context.Context, r *gosquare.Request) (*gosquare.Response, error) {
+			if r.Operation == "SubmitBatch" {
+				if batches++; batches == 2 {
+					srv.InjectFault(gosquaretest.Fault{Path: "/v1/L/payments", StatusCode: http.StatusNotFound, Message: "page gone"})
+				}
+			}
+			return next(ctx, r)
+		}
+	})
+
+	call := c.ListPaymentsWithOptionsBatchCall("", "L", nil)
+	opts := &gosquare.SubmitBatchOptions{FollowPages: true}
+	if _, err := c.SubmitBatchWithOptions("", []*gosquare.BatchRequest{call.BatchRequest}, opts); err != nil {
+		t.Fatal(err)
+	}
+	_, err := call.Result()
+	var apiErr *gosquare.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Result() error = %v, want an *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Type != "not_found" || apiErr.Message != "page gone" {
+		t.Errorf("Result() error = %+v, want the 404 of the second page", apiErr)
+	}
+}
+
+func TestSubmitBatchInvalidLink(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	srv.SetPageSize(2)
+	seedPayments(srv, "L", 5)
+	c := srv.Client()
+	// point the list's next page elsewhere, as a broken or hostile server might
+	c.Middleware = append(c.Middleware, func(next gosquare.Handler) gosquare.Handler {
+		return func(ctx context.Context, r *gosquare.Request) (*gosquare.Response, error) {
+			resp, err := next(ctx, r)
+			if err == nil {
+				for _, br := range *resp.Result.(*[]*gosquare.BatchResponse) {
+					if len(br.Headers.Get("Link")) > 0 {
+						br.Headers["Link"] = "<https://evil.example.com/v1/L/payments>;rel='next'"
+					}
+				}
+			}
+			return resp, err
+		}
+	})
+
+	list := c.ListPaymentsWithOptionsBatchCall("", "L", nil)
+	create := c.CreateItemBatchCall("", "L", &gosquare.CreateItemReqObject{ID: "I1", Name: "Tea"})
+	resps, err := c.SubmitBatch("", []*gosquare.BatchRequest{list.BatchRequest, create.BatchRequest})
+	if err != nil {
+		t.Fatalf("SubmitBatch failed as a whole: %v", err)
+	}
+	if len(resps) != 2 {
+		t.Fatalf("got %d responses, want 2", len(resps))
+	}
+	if _, err := list.Result(); !errors.Is(err, gosquare.ErrInvalidLinkHeader) {
+		t.Errorf("list Result() error = %v, want ErrInvalidLinkHeader", err)
+	}
+	if item, err := create.Result(); err != nil || item.ID != "I1" {
+		t.Errorf("create Result() = %+v, %v", item, err)
+	}
+}
+
+func TestFollowBatchPages(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	srv.SetPageSize(2)
+	seedPayments(srv, "L", 5)
+	c := srv.Client()
+
+	call := c.ListPaymentsWithOptionsBatchCall("", "L", nil)
+	opts := &gosquare.SubmitBatchOptions{FollowPages: true}
+	resps, err := c.SubmitBatchWithOptions("", []*gosquare.BatchRequest{call.BatchRequest}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payments, err := call.Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payments) != 5 || resps[0].NextRequest != nil {
+		t.Errorf("got %d payments, NextRequest %v, want 5 and nil", len(payments), resps[0].NextRequest)
+	}
+}
+
+func TestExecuteBatch(t *testing.T) {
+	const n = 95
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	seedPayments(srv, "L", n)
+	// slow the batches down so that chunks overlap
+	srv.InjectFault(gosquaretest.Fault{Path: "/v1/batch", Delay: 20 * time.Millisecond})
+	c := srv.Client()
+	var (
+		mu               sync.Mutex
+		sizes            []int
+		inFlight, maxFly int
+	)
+	c.Middleware = append(c.Middleware, func(next gosquare.Handler) gosquare.Handler {
+		return func(ctx context.Context, r *gosquare.Request) (*gosquare.Response, error) {
+			mu.Lock()
+			sizes = append(sizes, len(r.Batch))
+			inFlight++
+			maxFly = max(maxFly, inFlight)
+			mu.Unlock()
+			defer func() {
+				mu.Lock()
+				inFlight--
+				mu.Unlock()
+			}()
+			return next(ctx, r)
+		}
+	})
+
+	// in reverse order, the responses must follow the requests, not the payments
+	calls := make([]*gosquare.BatchCall[*gosquare.Payment], n)
+	reqs := make([]*gosquare.BatchRequest, n)
+	for i := range calls {
+		calls[i] = c.RetrievePaymentBatchCall("", "L", fmt.Sprintf("P%03d", n-1-i))
+		reqs[i] = calls[i].BatchRequest
+	}
+	res, err := c.ExecuteBatch("", reqs, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(sizes)
+	if got, want := fmt.Sprint(sizes), "[5 30 30 30]"; got != want {
+		t.Errorf("sent batches of %s, want %s", got, want)
+	}
+	if maxFly > 2 {
+		t.Errorf("%d batches were in flight at once, want at most 2", maxFly)
+	}
+	if len(res.Responses) != n || len(res.ByRequestID) != n || len(res.Errors) != 0 {
+		t.Fatalf("got %d responses, %d by request id and %d errors", len(res.Responses), len(res.ByRequestID), len(res.Errors))
+	}
+	for i, call := range calls {
+		if res.Responses[i] != res.ByRequestID[call.RequestID] {
+			t.Errorf("response %d isn't the response of its request id", i)
+		}
+		p, err := call.Result()
+		if want := fmt.Sprintf("P%03d", n-1-i); err != nil || p.ID != want {
+			t.Errorf("call %d = %v, %v, want %s", i, p, err, want)
+		}
+	}
+}
+
+func TestExecuteBatchChunkError(t *testing.T) {
+	const n = 95
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	seedPayments(srv, "L", 1)
+	c := srv.Client()
+	reqs := make([]*gosquare.BatchRequest, n)
+	for i := range reqs {
+		reqs[i], _ = c.RetrievePaymentBatchRequest("", "L", "P000")
+	}
+	errLost := errors.New("chunk lost")
+	c.Middleware = append(c.Middleware, func(next gosquare.Handler) gosquare.Handler {
+		return func(ctx context.Context, r *gosquare.Request) (*gosquare.Response, error) {
+			if r.Batch[0] == reqs[30] {
+				return nil, errLost
+			}
+			return next(ctx, r)
+		}
+	})
+
+	res, err := c.ExecuteBatch("", reqs, 3)
+	if !errors.Is(err, errLost) {
+		t.Fatalf("got error %v, want the lost chunk's", err)
+	}
+	if len(res.Errors) != 1 || res.Errors[0].Start != 30 || res.Errors[0].End != 60 || !errors.Is(res.Errors[0], errLost) {
+		t.Fatalf("got chunk errors %v, want requests 30 to 59", res.Errors)
+	}
+	for i, resp := range res.Responses {
+		if failed := i >= 30 && i < 60; failed != (resp == nil) {
+			t.Errorf("response %d = %v, failed chunk: %v", i, resp, failed)
+		}
+	}
+	if len(res.ByRequestID) != n-30 {
+		t.Errorf("got %d responses by request id, want %d", len(res.ByRequestID), n-30)
+	}
+}
+
+// BenchmarkSubmitBatch submits a batch of MaxBatchRequests payment lists
+// of 100 payments each and decodes their results.
+func BenchmarkSubmitBatch(b *testing.B) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	seedPayments(srv, "L", 100)
+	c := srv.Client()
+	calls := make([]*gosquare.BatchCall[[]*gosquare.Payment], gosquare.MaxBatchRequests)
+	reqs := make([]*gosquare.BatchRequest, len(calls))
+	for i := range calls {
+		calls[i] = c.ListPaymentsWithOptionsBatchCall("", "L", nil)
+		reqs[i] = calls[i].BatchRequest
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := c.SubmitBatch("", reqs); err != nil {
+			b.Fatal(err)
+		}
+		for _, bc := range calls {
+			if ps, err := bc.Result(); err != nil || len(ps) != 100 {
+				b.Fatalf("got %d payments, %v, want 100", len(ps), err)
+			}
+		}
+	}
+}
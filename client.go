@@ -0,0 +1,95 @@
+package gosquare
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	// ProductionURL is the base url of Square's production Connect API.
+	ProductionURL = "https://connect.squareup.com"
+	// SandboxURL is the base url of Square's sandbox Connect API.
+	SandboxURL = "https://connect.squareupsandbox.com"
+)
+
+// Client holds the configuration used to talk to the Square Connect API.
+// Every endpoint in this lib is available as a method on Client, and the
+// package-level functions are thin wrappers around DefaultClient.
+//
+// The zero value is a usable client that talks to ProductionURL with
+// http.DefaultClient. A Client must not be copied after first use and its
+// fields should not be modified while requests are in flight.
+type Client struct {
+	// BaseURL is the url every relative endpoint path is appended to,
+	// it defaults to ProductionURL. It must not end with a "/".
+	BaseURL string
+	// HTTPClient is used to perform requests, it defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Token is the access token used for any call made with an empty token.
+	// It is never used for the oauth2 endpoints, which authenticate with
+	// your application's secret instead.
+	Token string
+	// UserAgent, if set, is sent as the "User-Agent" header of every request.
+	UserAgent string
+	// Timeout, if non-zero, limits the time a single request may take,
+	// including reading the response body.
+	Timeout time.Duration
+	// Retry, if set, is the policy used to retry failed requests.
+	// A nil Retry makes every call a single attempt.
+	Retry *RetryPolicy
+	// Limiter, if set, throttles the requests made by the client.
+	Limiter *RateLimiter
+	// Coalescer, if set, gathers the retrieve calls made concurrently
+	// into batches.
+	Coalescer *Coalescer
+	// Middleware wraps every call the client makes, the first being the outermost.
+	Middleware []Middleware
+	// Logger, if set, receives a record of every call the client makes.
+	// Access tokens and other secrets are never logged.
+	Logger *slog.Logger
+	// Tracer, if set, records a span for every call the client makes.
+	Tracer Tracer
+	// Metrics, if set, collects measurements of every call the client makes.
+	Metrics Metrics
+	// Prefetch, if positive, makes the Iter methods and IterFrom fetch up to
+	// Prefetch pages ahead in the background, while the consumer is busy with
+	// the current one. Results are still yielded in order and errors still end
+	// the iteration, once the pages before them are consumed. Breaking out of
+	// the loop cancels the page being fetched.
+	Prefetch int
+}
+
+// DefaultClient is the Client used by the package-level functions.
+var DefaultClient = &Client{}
+
+// NewClient returns a Client that talks to baseURL and uses token
+// for every call made with an empty access token.
+// Pass ProductionURL or SandboxURL as baseURL to talk to Square.
+func NewClient(baseURL, token string) *Client {
+	return &Client{
+		BaseURL: baseURL,
+		Token:   token,
+	}
+}
+
+func (c *Client) baseURL() string {
+	if len(c.BaseURL) > 0 {
+		return c.BaseURL
+	}
+	return ProductionURL
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (c *Client) accessToken(token string) string {
+	if len(token) > 0 {
+		return token
+	}
+	return c.Token
+}
@@ -0,0 +1,194 @@
+package gosquare
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The default Coalescer.Window.
+const _DefaultCoalesceWindow = 5 * time.Millisecond
+
+// Coalescer gathers the retrieve calls (RetrieveItem, RetrievePayment,
+// RetrieveEmployee, ...) a Client makes concurrently into SubmitBatch calls.
+// Set it as Client.Coalescer to opt in.
+//
+// The first call waits for Window for others to join it, and the batch is
+// sent at the end of the window, or as soon as it holds MaxBatch different
+// requests. Calls for the same path with the same access token share one
+// batched request. Each call still gets its own result, or its own error: an
+// *APIError if its request failed inside the batch, the batch's error if the
+// batch as a whole failed. A window with a single call sends it on its own.
+//
+// The batch is made with the context values of the first call of its window,
+// its trace and logger for instance, but not with its deadline or cancellation:
+// cancelling a call only makes it return early. The calls to the oauth2
+// endpoints are never coalesced, as SubmitBatch can't make them.
+//
+// A batch goes through the Client's Middleware, Tracer and Logger as a single
+// SubmitBatch Request whose Batch holds the coalesced calls, see
+// BatchRequest.Operation, and the Tracer gives each of them a child span. The
+// Metrics record each coalesced call under its own operation, in addition to
+// the SubmitBatch call.
+//
+// A Coalescer may be shared by several Clients and is safe for concurrent use.
+// Its exported fields must not be changed once it is in use.
+type Coalescer struct {
+	// Window is how long a batch gathers calls. Default value: 5ms
+	Window time.Duration
+	// MaxBatch is the most requests in a batch, at most MaxBatchRequests.
+	// Default value: MaxBatchRequests
+	MaxBatch int
+
+	mu      sync.Mutex
+	pending map[*Client]*coalescedBatch
+}
+
+// NewCoalescer returns a Coalescer that gathers the calls made within window.
+func NewCoalescer(window time.Duration) *Coalescer {
+	return &Coalescer{Window: window}
+}
+
+type coalescedBatch struct {
+	// the context of the first call, without its cancellation
+	ctx context.Context
+	// the calls by access token and path, and in the order they were made
+	calls map[string]*coalescedCall
+	order []*coalescedCall
+	timer *time.Timer
+}
+
+type coalescedCall struct {
+	br   *BatchRequest
+	body json.RawMessage
+	err  error
+	done chan struct{}
+}
+
+// coalescable reports whether a call can be coalesced,
+// it must retrieve a single object with an access token.
+func coalescable(operation, method, path string) bool {
+	return method == "GET" && strings.HasPrefix(operation, "Retrieve") && !isOAuth(path)
+}
+
+// do makes the call through the batch being gathered for c,
+// and decodes its response body into result.
+func (co *Coalescer) do(ctx context.Context, c *Client, operation, path, token string, result interface{}) error {
+	token = c.accessToken(token)
+	key := token + " " + path
+	co.mu.Lock()
+	if co.pending == nil {
+		co.pending = make(map[*Client]*coalescedBatch)
+	}
+	b := co.pending[c]
+	if b == nil {
+		b = &coalescedBatch{ctx: context.WithoutCancel(ctx), calls: make(map[string]*coalescedCall)}
+		co.pending[c] = b
+		b.timer = time.AfterFunc(co.window(), func() {
+			co.mu.Lock()
+			if co.pending[c] != b {
+				// already sent, full
+				co.mu.Unlock()
+				return
+			}
+			delete(co.pending, c)
+			co.mu.Unlock()
+			co.send(c, b)
+		})
+	}
+	call := b.calls[key]
+	if call == nil {
+		br, _ := newBatchRequest(operation, "GET", path, token, nil, new(json.RawMessage))
+		call = &coalescedCall{br: br, done: make(chan struct{})}
+		b.calls[key] = call
+		b.order = append(b.order, call)
+	}
+	full := len(b.order) >= co.maxBatch()
+	if full {
+		delete(co.pending, c)
+		b.timer.Stop()
+	}
+	co.mu.Unlock()
+	if full {
+		go co.send(c, b)
+	}
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if call.err != nil || result == nil {
+		return call.err
+	}
+	return json.Unmarshal(call.body, result)
+}
+
+// send makes the calls of b and hands each its outcome.
+func (co *Coalescer) send(c *Client, b *coalescedBatch) {
+	defer func() {
+		for _, call := range b.order {
+			close(call.done)
+		}
+	}()
+	ctx := b.ctx
+	if len(b.order) == 1 {
+		call := b.order[0]
+		_, call.err = c.baseSquareRequest(ctx, &Request{
+			Operation: call.br.operation,
+			Method:    "GET",
+			Path:      call.br.RelativePath,
+			Token:     call.br.AccessToken,
+			Result:    &call.body,
+		})
+		return
+	}
+	reqs := make([]*BatchRequest, len(b.order))
+	for i, call := range b.order {
+		reqs[i] = call.br
+	}
+	start := time.Now()
+	defer func() {
+		if m := c.Metrics; m != nil {
+			for _, call := range b.order {
+				class := "2xx"
+				if call.err != nil {
+					class = statusClass(nil, call.err)
+				}
+				m.ObserveRequest(call.br.operation, class, time.Since(start))
+			}
+		}
+	}()
+	if _, err := c.submitBatch(ctx, "", reqs); err != nil {
+		for _, call := range b.order {
+			call.err = err
+		}
+		return
+	}
+	for _, call := range b.order {
+		resp := call.br.response
+		switch {
+		case resp == nil:
+			call.err = ErrNoBatchResponse
+		case resp.Err() != nil:
+			call.err = resp.Err()
+		default:
+			call.body = *call.br.result.(*json.RawMessage)
+		}
+	}
+}
+
+func (co *Coalescer) window() time.Duration {
+	if co.Window > 0 {
+		return co.Window
+	}
+	return _DefaultCoalesceWindow
+}
+
+func (co *Coalescer) maxBatch() int {
+	if co.MaxBatch > 0 && co.MaxBatch < MaxBatchRequests {
+		return co.MaxBatch
+	}
+	return MaxBatchRequests
+}
@@ -0,0 +1,124 @@
+package gosquare_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+)
+
+// opMetrics counts the calls observed by operation.
+type opMetrics struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (m *opMetrics) ObserveRequest(operation, statusClass string, latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls[operation+" "+statusClass]++
+}
+
+func (m *opMetrics) IncRetries(operation string) {}
+
+func (m *opMetrics) IncPages(operation string) {}
+
+type callerKey struct{}
+
+func TestCoalescer(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	seedPayments(srv, "L", 10)
+	c := srv.Client()
+	c.Coalescer = gosquare.NewCoalescer(100 * time.Millisecond)
+	metrics := &opMetrics{calls: make(map[string]int)}
+	c.Metrics = metrics
+	var (
+		mu      sync.Mutex
+		batches []*gosquare.Request
+		callers []interface{}
+	)
+	c.Middleware = append(c.Middleware, func(next gosquare.Handler) gosquare.Handler {
+		return func(ctx context.Context, r *gosquare.Request) (*gosquare.Response, error) {
+			mu.Lock()
+			batches = append(batches, r)
+			callers = append(callers, ctx.Value(callerKey{}))
+			mu.Unlock()
+			return next(ctx, r)
+		}
+	})
+
+	const n = 20
+	var wg sync.WaitGroup
+	payments := make([]*gosquare.Payment, n)
+	errs := make([]error, n+1)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := context.WithValue(context.Background(), callerKey{}, "caller")
+			payments[i], errs[i] = c.RetrievePaymentContext(ctx, "", "L", fmt.Sprintf("P%03d", i%10))
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ctx := context.WithValue(context.Background(), callerKey{}, "caller")
+		_, errs[n] = c.RetrievePaymentContext(ctx, "", "L", "missing")
+	}()
+	wg.Wait()
+
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			t.Fatalf("call %d: %v", i, errs[i])
+		}
+		if want := fmt.Sprintf("P%03d", i%10); payments[i].ID != want {
+			t.Errorf("call %d got payment %s, want %s", i, payments[i].ID, want)
+		}
+	}
+	if !gosquare.IsNotFound(errs[n]) {
+		t.Errorf("missing payment: got %v, want a 404", errs[n])
+	}
+	var apiErr *gosquare.APIError
+	if !errors.As(errs[n], &apiErr) || apiErr.Path != "/v1/L/payments/missing" {
+		t.Errorf("missing payment: got %+v, want the path of its request", apiErr)
+	}
+
+	if len(batches) != 1 || batches[0].Operation != "SubmitBatch" || len(batches[0].Batch) != 11 {
+		t.Fatalf("got %d calls through the middleware, want one SubmitBatch of the 11 distinct calls", len(batches))
+	}
+	for _, br := range batches[0].Batch {
+		if br.Operation() != "RetrievePayment" {
+			t.Errorf("batched request operation %q, want RetrievePayment", br.Operation())
+		}
+	}
+	if callers[0] != "caller" {
+		t.Errorf("the batch context has lost the values of its callers' context")
+	}
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	if metrics.calls["RetrievePayment 2xx"] != 10 || metrics.calls["RetrievePayment 4xx"] != 1 || metrics.calls["SubmitBatch 2xx"] != 1 {
+		t.Errorf("metrics %v, want 10 RetrievePayment 2xx, 1 4xx and 1 SubmitBatch", metrics.calls)
+	}
+}
+
+func TestCoalescerSingleCall(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	seedPayments(srv, "L", 1)
+	c := srv.Client()
+	c.Coalescer = gosquare.NewCoalescer(time.Millisecond)
+	p, err := c.RetrievePayment("", "L", "P000")
+	if err != nil || p.ID != "P000" {
+		t.Fatal(p, err)
+	}
+	reqs := srv.Requests()
+	if len(reqs) != 1 || reqs[0].Path != "/v1/L/payments/P000" {
+		t.Errorf("got requests %v, want the call sent as itself", reqs)
+	}
+}
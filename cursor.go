@@ -0,0 +1,58 @@
+package gosquare
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCursor is returned, wrapped, by ResumeNextRequest for a cursor
+// that wasn't returned by NextRequest.Cursor.
+var ErrInvalidCursor = errors.New("gosquare: invalid cursor")
+
+// The version of the cursor format, bumped if it ever changes.
+const _CursorVersion = 1
+
+type cursor struct {
+	Version   int    `json:"v"`
+	Operation string `json:"op"`
+	Path      string `json:"path"`
+	Page      int    `json:"page"`
+}
+
+// Cursor returns an opaque string from which ResumeNextRequest rebuilds
+// the NextRequest, so a long walk through pages can be checkpointed and
+// resumed by another process. The cursor holds the path of the next page,
+// relative to the client's base url, but never the access token.
+func (nr *NextRequest) Cursor() string {
+	bts, _ := json.Marshal(&cursor{
+		Version:   _CursorVersion,
+		Operation: nr.operation,
+		Path:      nr.uri,
+		Page:      nr.page,
+	})
+	return base64.RawURLEncoding.EncodeToString(bts)
+}
+
+// ResumeNextRequest rebuilds the NextRequest a cursor was returned for,
+// authenticated with token, or the client's Token if empty.
+func (c *Client) ResumeNextRequest(cur, token string) (*NextRequest, error) {
+	bts, err := base64.RawURLEncoding.DecodeString(cur)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+	v := new(cursor)
+	if err := json.Unmarshal(bts, v); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+	if v.Version != _CursorVersion {
+		return nil, fmt.Errorf("%w: unknown version %d", ErrInvalidCursor, v.Version)
+	}
+	// the path is appended to the base url, it must not reach another host
+	if !strings.HasPrefix(v.Path, "/") || strings.HasPrefix(v.Path, "//") {
+		return nil, fmt.Errorf("%w: %q is not a relative path", ErrInvalidCursor, v.Path)
+	}
+	return &NextRequest{operation: v.Operation, uri: v.Path, token: token, client: c, page: v.Page}, nil
+}
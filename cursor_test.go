@@ -0,0 +1,76 @@
+package gosquare_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+)
+
+func TestCursor(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	srv.SetPageSize(2)
+	seedPayments(srv, "L", 5)
+	c := srv.Client()
+
+	_, nr, err := c.ListPaymentsWithOptions("SECRET_TOKEN", "L", nil)
+	if err != nil || nr == nil {
+		t.Fatalf("got %v, %v, want a next page", nr, err)
+	}
+	cur := nr.Cursor()
+	decoded, err := base64.RawURLEncoding.DecodeString(cur)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{cur, string(decoded)} {
+		if strings.Contains(s, "SECRET_TOKEN") {
+			t.Errorf("the cursor holds the access token: %s", s)
+		}
+	}
+
+	srv.SetTokens("NEW_TOKEN")
+	resumed, err := c.ResumeNextRequest(cur, "NEW_TOKEN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed.Cursor() != cur {
+		t.Errorf("the resumed cursor is %s, want %s", resumed.Cursor(), cur)
+	}
+	ps, err := gosquare.All(gosquare.IterFrom[*gosquare.Payment](context.Background(), resumed), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]string, len(ps))
+	for i, p := range ps {
+		ids[i] = p.ID
+	}
+	if got, want := fmt.Sprint(ids), "[P002 P003 P004]"; got != want {
+		t.Errorf("resumed walk got %s, want %s", got, want)
+	}
+}
+
+func TestResumeNextRequestInvalid(t *testing.T) {
+	c := gosquare.NewClient(gosquare.SandboxURL, "TOKEN")
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+	for _, cur := range []string{
+		"",
+		"not base64!",
+		encode("{"),
+		encode(`{"v":2,"op":"ListPayments","path":"/v1/L/payments?batch_token=X","page":1}`),
+		encode(`{"v":1,"op":"ListPayments","path":"v1/L/payments?batch_token=X","page":1}`),
+		encode(`{"v":1,"op":"ListPayments","path":"https://evil.example.com/v1/L/payments","page":1}`),
+		encode(`{"v":1,"op":"ListPayments","path":"//evil.example.com/v1/L/payments","page":1}`),
+	} {
+		if nr, err := c.ResumeNextRequest(cur, ""); !errors.Is(err, gosquare.ErrInvalidCursor) {
+			t.Errorf("ResumeNextRequest(%q) = %v, %v, want ErrInvalidCursor", cur, nr, err)
+		}
+	}
+}
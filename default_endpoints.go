@@ -0,0 +1,1935 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package gosquare
+
+import (
+	"context"
+	"iter"
+)
+
+// RetrieveBusiness calls RetrieveBusiness on DefaultClient.
+func RetrieveBusiness(token string) (*Merchant, error) {
+	return DefaultClient.RetrieveBusiness(token)
+}
+
+// RetrieveBusinessContext calls RetrieveBusinessContext on DefaultClient.
+func RetrieveBusinessContext(ctx context.Context, token string) (*Merchant, error) {
+	return DefaultClient.RetrieveBusinessContext(ctx, token)
+}
+
+// ListLocations calls ListLocations on DefaultClient.
+func ListLocations(token string) ([]*Merchant, *NextRequest, error) {
+	return DefaultClient.ListLocations(token)
+}
+
+// ListLocationsContext calls ListLocationsContext on DefaultClient.
+func ListLocationsContext(ctx context.Context, token string) ([]*Merchant, *NextRequest, error) {
+	return DefaultClient.ListLocationsContext(ctx, token)
+}
+
+// CreateEmployee calls CreateEmployee on DefaultClient.
+func CreateEmployee(token string, reqObj *CreateEmployeeReqObject) (*Employee, error) {
+	return DefaultClient.CreateEmployee(token, reqObj)
+}
+
+// CreateEmployeeContext calls CreateEmployeeContext on DefaultClient.
+func CreateEmployeeContext(ctx context.Context, token string, reqObj *CreateEmployeeReqObject) (*Employee, error) {
+	return DefaultClient.CreateEmployeeContext(ctx, token, reqObj)
+}
+
+// ListEmployees calls ListEmployees on DefaultClient.
+//
+// Deprecated: use ListEmployeesWithOptions, which takes typed filters.
+func ListEmployees(token, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID string, limit int) ([]*Employee, *NextRequest, error) {
+	return DefaultClient.ListEmployees(token, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID, limit)
+}
+
+// ListEmployeesContext calls ListEmployeesContext on DefaultClient.
+//
+// Deprecated: use ListEmployeesWithOptionsContext, which takes typed filters.
+func ListEmployeesContext(ctx context.Context, token, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID string, limit int) ([]*Employee, *NextRequest, error) {
+	return DefaultClient.ListEmployeesContext(ctx, token, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID, limit)
+}
+
+// ListEmployeesWithOptions calls ListEmployeesWithOptions on DefaultClient.
+func ListEmployeesWithOptions(token string, opts *ListEmployeesOptions) ([]*Employee, *NextRequest, error) {
+	return DefaultClient.ListEmployeesWithOptions(token, opts)
+}
+
+// ListEmployeesWithOptionsContext calls ListEmployeesWithOptionsContext on DefaultClient.
+func ListEmployeesWithOptionsContext(ctx context.Context, token string, opts *ListEmployeesOptions) ([]*Employee, *NextRequest, error) {
+	return DefaultClient.ListEmployeesWithOptionsContext(ctx, token, opts)
+}
+
+// RetrieveEmployee calls RetrieveEmployee on DefaultClient.
+func RetrieveEmployee(token, employeeID string) (*Employee, error) {
+	return DefaultClient.RetrieveEmployee(token, employeeID)
+}
+
+// RetrieveEmployeeContext calls RetrieveEmployeeContext on DefaultClient.
+func RetrieveEmployeeContext(ctx context.Context, token, employeeID string) (*Employee, error) {
+	return DefaultClient.RetrieveEmployeeContext(ctx, token, employeeID)
+}
+
+// UpdateEmployee calls UpdateEmployee on DefaultClient.
+func UpdateEmployee(token, employeeID string, reqObj *UpdateEmployeeReqObject) (*Employee, error) {
+	return DefaultClient.UpdateEmployee(token, employeeID, reqObj)
+}
+
+// UpdateEmployeeContext calls UpdateEmployeeContext on DefaultClient.
+func UpdateEmployeeContext(ctx context.Context, token, employeeID string, reqObj *UpdateEmployeeReqObject) (*Employee, error) {
+	return DefaultClient.UpdateEmployeeContext(ctx, token, employeeID, reqObj)
+}
+
+// CreateRole calls CreateRole on DefaultClient.
+func CreateRole(token string, reqObj *CreateRoleReqObject) (*EmployeeRole, error) {
+	return DefaultClient.CreateRole(token, reqObj)
+}
+
+// CreateRoleContext calls CreateRoleContext on DefaultClient.
+func CreateRoleContext(ctx context.Context, token string, reqObj *CreateRoleReqObject) (*EmployeeRole, error) {
+	return DefaultClient.CreateRoleContext(ctx, token, reqObj)
+}
+
+// ListRoles calls ListRoles on DefaultClient.
+//
+// Deprecated: use ListRolesWithOptions, which takes typed filters.
+func ListRoles(token, order string, limit int) ([]*EmployeeRole, *NextRequest, error) {
+	return DefaultClient.ListRoles(token, order, limit)
+}
+
+// ListRolesContext calls ListRolesContext on DefaultClient.
+//
+// Deprecated: use ListRolesWithOptionsContext, which takes typed filters.
+func ListRolesContext(ctx context.Context, token, order string, limit int) ([]*EmployeeRole, *NextRequest, error) {
+	return DefaultClient.ListRolesContext(ctx, token, order, limit)
+}
+
+// ListRolesWithOptions calls ListRolesWithOptions on DefaultClient.
+func ListRolesWithOptions(token string, opts *ListRolesOptions) ([]*EmployeeRole, *NextRequest, error) {
+	return DefaultClient.ListRolesWithOptions(token, opts)
+}
+
+// ListRolesWithOptionsContext calls ListRolesWithOptionsContext on DefaultClient.
+func ListRolesWithOptionsContext(ctx context.Context, token string, opts *ListRolesOptions) ([]*EmployeeRole, *NextRequest, error) {
+	return DefaultClient.ListRolesWithOptionsContext(ctx, token, opts)
+}
+
+// RetrieveRole calls RetrieveRole on DefaultClient.
+func RetrieveRole(token, roleID string) (*EmployeeRole, error) {
+	return DefaultClient.RetrieveRole(token, roleID)
+}
+
+// RetrieveRoleContext calls RetrieveRoleContext on DefaultClient.
+func RetrieveRoleContext(ctx context.Context, token, roleID string) (*EmployeeRole, error) {
+	return DefaultClient.RetrieveRoleContext(ctx, token, roleID)
+}
+
+// UpdateRole calls UpdateRole on DefaultClient.
+func UpdateRole(token, roleID string, reqObj *UpdateRoleReqObject) (*EmployeeRole, error) {
+	return DefaultClient.UpdateRole(token, roleID, reqObj)
+}
+
+// UpdateRoleContext calls UpdateRoleContext on DefaultClient.
+func UpdateRoleContext(ctx context.Context, token, roleID string, reqObj *UpdateRoleReqObject) (*EmployeeRole, error) {
+	return DefaultClient.UpdateRoleContext(ctx, token, roleID, reqObj)
+}
+
+// CreateTimecard calls CreateTimecard on DefaultClient.
+func CreateTimecard(token string, reqObj *CreateTimecardReqObject) (*Timecard, error) {
+	return DefaultClient.CreateTimecard(token, reqObj)
+}
+
+// CreateTimecardContext calls CreateTimecardContext on DefaultClient.
+func CreateTimecardContext(ctx context.Context, token string, reqObj *CreateTimecardReqObject) (*Timecard, error) {
+	return DefaultClient.CreateTimecardContext(ctx, token, reqObj)
+}
+
+// ListTimecards calls ListTimecards on DefaultClient.
+//
+// Deprecated: use ListTimecardsWithOptions, which takes typed filters.
+func ListTimecards(token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt string, deleted bool, limit int) ([]*Timecard, *NextRequest, error) {
+	return DefaultClient.ListTimecards(token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt, deleted, limit)
+}
+
+// ListTimecardsContext calls ListTimecardsContext on DefaultClient.
+//
+// Deprecated: use ListTimecardsWithOptionsContext, which takes typed filters.
+func ListTimecardsContext(ctx context.Context, token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt string, deleted bool, limit int) ([]*Timecard, *NextRequest, error) {
+	return DefaultClient.ListTimecardsContext(ctx, token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt, deleted, limit)
+}
+
+// ListTimecardsWithOptions calls ListTimecardsWithOptions on DefaultClient.
+func ListTimecardsWithOptions(token string, opts *ListTimecardsOptions) ([]*Timecard, *NextRequest, error) {
+	return DefaultClient.ListTimecardsWithOptions(token, opts)
+}
+
+// ListTimecardsWithOptionsContext calls ListTimecardsWithOptionsContext on DefaultClient.
+func ListTimecardsWithOptionsContext(ctx context.Context, token string, opts *ListTimecardsOptions) ([]*Timecard, *NextRequest, error) {
+	return DefaultClient.ListTimecardsWithOptionsContext(ctx, token, opts)
+}
+
+// RetrieveTimecard calls RetrieveTimecard on DefaultClient.
+func RetrieveTimecard(token, timecardID string) (*Timecard, error) {
+	return DefaultClient.RetrieveTimecard(token, timecardID)
+}
+
+// RetrieveTimecardContext calls RetrieveTimecardContext on DefaultClient.
+func RetrieveTimecardContext(ctx context.Context, token, timecardID string) (*Timecard, error) {
+	return DefaultClient.RetrieveTimecardContext(ctx, token, timecardID)
+}
+
+// UpdateTimecard calls UpdateTimecard on DefaultClient.
+func UpdateTimecard(token, timecardID string, reqObj *UpdateTimecardReqObject) (*Timecard, error) {
+	return DefaultClient.UpdateTimecard(token, timecardID, reqObj)
+}
+
+// UpdateTimecardContext calls UpdateTimecardContext on DefaultClient.
+func UpdateTimecardContext(ctx context.Context, token, timecardID string, reqObj *UpdateTimecardReqObject) (*Timecard, error) {
+	return DefaultClient.UpdateTimecardContext(ctx, token, timecardID, reqObj)
+}
+
+// DeleteTimecard calls DeleteTimecard on DefaultClient.
+func DeleteTimecard(token, timecardID string) error {
+	return DefaultClient.DeleteTimecard(token, timecardID)
+}
+
+// DeleteTimecardContext calls DeleteTimecardContext on DefaultClient.
+func DeleteTimecardContext(ctx context.Context, token, timecardID string) error {
+	return DefaultClient.DeleteTimecardContext(ctx, token, timecardID)
+}
+
+// ListTimecardEvents calls ListTimecardEvents on DefaultClient.
+func ListTimecardEvents(token, timecardID string) ([]*TimecardEvent, *NextRequest, error) {
+	return DefaultClient.ListTimecardEvents(token, timecardID)
+}
+
+// ListTimecardEventsContext calls ListTimecardEventsContext on DefaultClient.
+func ListTimecardEventsContext(ctx context.Context, token, timecardID string) ([]*TimecardEvent, *NextRequest, error) {
+	return DefaultClient.ListTimecardEventsContext(ctx, token, timecardID)
+}
+
+// ListCashDrawerShifts calls ListCashDrawerShifts on DefaultClient.
+//
+// Deprecated: use ListCashDrawerShiftsWithOptions, which takes typed filters.
+func ListCashDrawerShifts(token, locationID, beginTime, endTime, order string) ([]*CashDrawerShift, *NextRequest, error) {
+	return DefaultClient.ListCashDrawerShifts(token, locationID, beginTime, endTime, order)
+}
+
+// ListCashDrawerShiftsContext calls ListCashDrawerShiftsContext on DefaultClient.
+//
+// Deprecated: use ListCashDrawerShiftsWithOptionsContext, which takes typed filters.
+func ListCashDrawerShiftsContext(ctx context.Context, token, locationID, beginTime, endTime, order string) ([]*CashDrawerShift, *NextRequest, error) {
+	return DefaultClient.ListCashDrawerShiftsContext(ctx, token, locationID, beginTime, endTime, order)
+}
+
+// ListCashDrawerShiftsWithOptions calls ListCashDrawerShiftsWithOptions on DefaultClient.
+func ListCashDrawerShiftsWithOptions(token, locationID string, opts *ListCashDrawerShiftsOptions) ([]*CashDrawerShift, *NextRequest, error) {
+	return DefaultClient.ListCashDrawerShiftsWithOptions(token, locationID, opts)
+}
+
+// ListCashDrawerShiftsWithOptionsContext calls ListCashDrawerShiftsWithOptionsContext on DefaultClient.
+func ListCashDrawerShiftsWithOptionsContext(ctx context.Context, token, locationID string, opts *ListCashDrawerShiftsOptions) ([]*CashDrawerShift, *NextRequest, error) {
+	return DefaultClient.ListCashDrawerShiftsWithOptionsContext(ctx, token, locationID, opts)
+}
+
+// RetrieveCashDrawerShift calls RetrieveCashDrawerShift on DefaultClient.
+func RetrieveCashDrawerShift(token, locationID, shiftID string) (*CashDrawerShift, error) {
+	return DefaultClient.RetrieveCashDrawerShift(token, locationID, shiftID)
+}
+
+// RetrieveCashDrawerShiftContext calls RetrieveCashDrawerShiftContext on DefaultClient.
+func RetrieveCashDrawerShiftContext(ctx context.Context, token, locationID, shiftID string) (*CashDrawerShift, error) {
+	return DefaultClient.RetrieveCashDrawerShiftContext(ctx, token, locationID, shiftID)
+}
+
+// ListPayments calls ListPayments on DefaultClient.
+//
+// Deprecated: use ListPaymentsWithOptions, which takes typed filters.
+func ListPayments(token, locationID, beginTime, endTime, order string, limit int) ([]*Payment, *NextRequest, error) {
+	return DefaultClient.ListPayments(token, locationID, beginTime, endTime, order, limit)
+}
+
+// ListPaymentsContext calls ListPaymentsContext on DefaultClient.
+//
+// Deprecated: use ListPaymentsWithOptionsContext, which takes typed filters.
+func ListPaymentsContext(ctx context.Context, token, locationID, beginTime, endTime, order string, limit int) ([]*Payment, *NextRequest, error) {
+	return DefaultClient.ListPaymentsContext(ctx, token, locationID, beginTime, endTime, order, limit)
+}
+
+// ListPaymentsWithOptions calls ListPaymentsWithOptions on DefaultClient.
+func ListPaymentsWithOptions(token, locationID string, opts *ListPaymentsOptions) ([]*Payment, *NextRequest, error) {
+	return DefaultClient.ListPaymentsWithOptions(token, locationID, opts)
+}
+
+// ListPaymentsWithOptionsContext calls ListPaymentsWithOptionsContext on DefaultClient.
+func ListPaymentsWithOptionsContext(ctx context.Context, token, locationID string, opts *ListPaymentsOptions) ([]*Payment, *NextRequest, error) {
+	return DefaultClient.ListPaymentsWithOptionsContext(ctx, token, locationID, opts)
+}
+
+// RetrievePayment calls RetrievePayment on DefaultClient.
+func RetrievePayment(token, locationID, paymentID string) (*Payment, error) {
+	return DefaultClient.RetrievePayment(token, locationID, paymentID)
+}
+
+// RetrievePaymentContext calls RetrievePaymentContext on DefaultClient.
+func RetrievePaymentContext(ctx context.Context, token, locationID, paymentID string) (*Payment, error) {
+	return DefaultClient.RetrievePaymentContext(ctx, token, locationID, paymentID)
+}
+
+// ListSettlements calls ListSettlements on DefaultClient.
+//
+// Deprecated: use ListSettlementsWithOptions, which takes typed filters.
+func ListSettlements(token, locationID, beginTime, endTime, order string, limit int, status string) ([]*Settlement, *NextRequest, error) {
+	return DefaultClient.ListSettlements(token, locationID, beginTime, endTime, order, limit, status)
+}
+
+// ListSettlementsContext calls ListSettlementsContext on DefaultClient.
+//
+// Deprecated: use ListSettlementsWithOptionsContext, which takes typed filters.
+func ListSettlementsContext(ctx context.Context, token, locationID, beginTime, endTime, order string, limit int, status string) ([]*Settlement, *NextRequest, error) {
+	return DefaultClient.ListSettlementsContext(ctx, token, locationID, beginTime, endTime, order, limit, status)
+}
+
+// ListSettlementsWithOptions calls ListSettlementsWithOptions on DefaultClient.
+func ListSettlementsWithOptions(token, locationID string, opts *ListSettlementsOptions) ([]*Settlement, *NextRequest, error) {
+	return DefaultClient.ListSettlementsWithOptions(token, locationID, opts)
+}
+
+// ListSettlementsWithOptionsContext calls ListSettlementsWithOptionsContext on DefaultClient.
+func ListSettlementsWithOptionsContext(ctx context.Context, token, locationID string, opts *ListSettlementsOptions) ([]*Settlement, *NextRequest, error) {
+	return DefaultClient.ListSettlementsWithOptionsContext(ctx, token, locationID, opts)
+}
+
+// RetrieveSettlement calls RetrieveSettlement on DefaultClient.
+func RetrieveSettlement(token, locationID, settlementID string) (*Settlement, error) {
+	return DefaultClient.RetrieveSettlement(token, locationID, settlementID)
+}
+
+// RetrieveSettlementContext calls RetrieveSettlementContext on DefaultClient.
+func RetrieveSettlementContext(ctx context.Context, token, locationID, settlementID string) (*Settlement, error) {
+	return DefaultClient.RetrieveSettlementContext(ctx, token, locationID, settlementID)
+}
+
+// CreateRefund calls CreateRefund on DefaultClient.
+func CreateRefund(token, locationID string, reqObj *CreateRefundReqObject) (*Refund, error) {
+	return DefaultClient.CreateRefund(token, locationID, reqObj)
+}
+
+// CreateRefundContext calls CreateRefundContext on DefaultClient.
+func CreateRefundContext(ctx context.Context, token, locationID string, reqObj *CreateRefundReqObject) (*Refund, error) {
+	return DefaultClient.CreateRefundContext(ctx, token, locationID, reqObj)
+}
+
+// ListRefunds calls ListRefunds on DefaultClient.
+//
+// Deprecated: use ListRefundsWithOptions, which takes typed filters.
+func ListRefunds(token, locationID, beginTime, endTime, order string, limit int) ([]*Refund, *NextRequest, error) {
+	return DefaultClient.ListRefunds(token, locationID, beginTime, endTime, order, limit)
+}
+
+// ListRefundsContext calls ListRefundsContext on DefaultClient.
+//
+// Deprecated: use ListRefundsWithOptionsContext, which takes typed filters.
+func ListRefundsContext(ctx context.Context, token, locationID, beginTime, endTime, order string, limit int) ([]*Refund, *NextRequest, error) {
+	return DefaultClient.ListRefundsContext(ctx, token, locationID, beginTime, endTime, order, limit)
+}
+
+// ListRefundsWithOptions calls ListRefundsWithOptions on DefaultClient.
+func ListRefundsWithOptions(token, locationID string, opts *ListRefundsOptions) ([]*Refund, *NextRequest, error) {
+	return DefaultClient.ListRefundsWithOptions(token, locationID, opts)
+}
+
+// ListRefundsWithOptionsContext calls ListRefundsWithOptionsContext on DefaultClient.
+func ListRefundsWithOptionsContext(ctx context.Context, token, locationID string, opts *ListRefundsOptions) ([]*Refund, *NextRequest, error) {
+	return DefaultClient.ListRefundsWithOptionsContext(ctx, token, locationID, opts)
+}
+
+// ListOrders calls ListOrders on DefaultClient.
+//
+// Deprecated: use ListOrdersWithOptions, which takes typed filters.
+func ListOrders(token, locationID string, limit int, order string) ([]*Order, *NextRequest, error) {
+	return DefaultClient.ListOrders(token, locationID, limit, order)
+}
+
+// ListOrdersContext calls ListOrdersContext on DefaultClient.
+//
+// Deprecated: use ListOrdersWithOptionsContext, which takes typed filters.
+func ListOrdersContext(ctx context.Context, token, locationID string, limit int, order string) ([]*Order, *NextRequest, error) {
+	return DefaultClient.ListOrdersContext(ctx, token, locationID, limit, order)
+}
+
+// ListOrdersWithOptions calls ListOrdersWithOptions on DefaultClient.
+func ListOrdersWithOptions(token, locationID string, opts *ListOrdersOptions) ([]*Order, *NextRequest, error) {
+	return DefaultClient.ListOrdersWithOptions(token, locationID, opts)
+}
+
+// ListOrdersWithOptionsContext calls ListOrdersWithOptionsContext on DefaultClient.
+func ListOrdersWithOptionsContext(ctx context.Context, token, locationID string, opts *ListOrdersOptions) ([]*Order, *NextRequest, error) {
+	return DefaultClient.ListOrdersWithOptionsContext(ctx, token, locationID, opts)
+}
+
+// RetrieveOrder calls RetrieveOrder on DefaultClient.
+func RetrieveOrder(token, locationID, orderID string) (*Order, error) {
+	return DefaultClient.RetrieveOrder(token, locationID, orderID)
+}
+
+// RetrieveOrderContext calls RetrieveOrderContext on DefaultClient.
+func RetrieveOrderContext(ctx context.Context, token, locationID, orderID string) (*Order, error) {
+	return DefaultClient.RetrieveOrderContext(ctx, token, locationID, orderID)
+}
+
+// UpdateOrder calls UpdateOrder on DefaultClient.
+func UpdateOrder(token, locationID, orderID string, reqObj *UpdateOrderReqObject) (*Order, error) {
+	return DefaultClient.UpdateOrder(token, locationID, orderID, reqObj)
+}
+
+// UpdateOrderContext calls UpdateOrderContext on DefaultClient.
+func UpdateOrderContext(ctx context.Context, token, locationID, orderID string, reqObj *UpdateOrderReqObject) (*Order, error) {
+	return DefaultClient.UpdateOrderContext(ctx, token, locationID, orderID, reqObj)
+}
+
+// ListBankAccounts calls ListBankAccounts on DefaultClient.
+func ListBankAccounts(token, locationID string) ([]*BankAccount, *NextRequest, error) {
+	return DefaultClient.ListBankAccounts(token, locationID)
+}
+
+// ListBankAccountsContext calls ListBankAccountsContext on DefaultClient.
+func ListBankAccountsContext(ctx context.Context, token, locationID string) ([]*BankAccount, *NextRequest, error) {
+	return DefaultClient.ListBankAccountsContext(ctx, token, locationID)
+}
+
+// RetrieveBankAccount calls RetrieveBankAccount on DefaultClient.
+func RetrieveBankAccount(token, locationID, bankAccountID string) (*BankAccount, error) {
+	return DefaultClient.RetrieveBankAccount(token, locationID, bankAccountID)
+}
+
+// RetrieveBankAccountContext calls RetrieveBankAccountContext on DefaultClient.
+func RetrieveBankAccountContext(ctx context.Context, token, locationID, bankAccountID string) (*BankAccount, error) {
+	return DefaultClient.RetrieveBankAccountContext(ctx, token, locationID, bankAccountID)
+}
+
+// CreateItem calls CreateItem on DefaultClient.
+func CreateItem(token, locationID string, reqObj *CreateItemReqObject) (*Item, error) {
+	return DefaultClient.CreateItem(token, locationID, reqObj)
+}
+
+// CreateItemContext calls CreateItemContext on DefaultClient.
+func CreateItemContext(ctx context.Context, token, locationID string, reqObj *CreateItemReqObject) (*Item, error) {
+	return DefaultClient.CreateItemContext(ctx, token, locationID, reqObj)
+}
+
+// ListItems calls ListItems on DefaultClient.
+func ListItems(token, locationID string) ([]*Item, *NextRequest, error) {
+	return DefaultClient.ListItems(token, locationID)
+}
+
+// ListItemsContext calls ListItemsContext on DefaultClient.
+func ListItemsContext(ctx context.Context, token, locationID string) ([]*Item, *NextRequest, error) {
+	return DefaultClient.ListItemsContext(ctx, token, locationID)
+}
+
+// RetrieveItem calls RetrieveItem on DefaultClient.
+func RetrieveItem(token, locationID, itemID string) (*Item, error) {
+	return DefaultClient.RetrieveItem(token, locationID, itemID)
+}
+
+// RetrieveItemContext calls RetrieveItemContext on DefaultClient.
+func RetrieveItemContext(ctx context.Context, token, locationID, itemID string) (*Item, error) {
+	return DefaultClient.RetrieveItemContext(ctx, token, locationID, itemID)
+}
+
+// UpdateItem calls UpdateItem on DefaultClient.
+func UpdateItem(token, locationID, itemID string, reqObj *UpdateItemReqObject) (*Item, error) {
+	return DefaultClient.UpdateItem(token, locationID, itemID, reqObj)
+}
+
+// UpdateItemContext calls UpdateItemContext on DefaultClient.
+func UpdateItemContext(ctx context.Context, token, locationID, itemID string, reqObj *UpdateItemReqObject) (*Item, error) {
+	return DefaultClient.UpdateItemContext(ctx, token, locationID, itemID, reqObj)
+}
+
+// DeleteItem calls DeleteItem on DefaultClient.
+func DeleteItem(token, locationID, itemID string) error {
+	return DefaultClient.DeleteItem(token, locationID, itemID)
+}
+
+// DeleteItemContext calls DeleteItemContext on DefaultClient.
+func DeleteItemContext(ctx context.Context, token, locationID, itemID string) error {
+	return DefaultClient.DeleteItemContext(ctx, token, locationID, itemID)
+}
+
+// CreateVariation calls CreateVariation on DefaultClient.
+func CreateVariation(token, locationID, itemID string, reqObj *CreateVariationReqObject) (*ItemVariation, error) {
+	return DefaultClient.CreateVariation(token, locationID, itemID, reqObj)
+}
+
+// CreateVariationContext calls CreateVariationContext on DefaultClient.
+func CreateVariationContext(ctx context.Context, token, locationID, itemID string, reqObj *CreateVariationReqObject) (*ItemVariation, error) {
+	return DefaultClient.CreateVariationContext(ctx, token, locationID, itemID, reqObj)
+}
+
+// UpdateVariation calls UpdateVariation on DefaultClient.
+func UpdateVariation(token, locationID, itemID, variationID string, reqObj *UpdateVariationReqObject) (*ItemVariation, error) {
+	return DefaultClient.UpdateVariation(token, locationID, itemID, variationID, reqObj)
+}
+
+// UpdateVariationContext calls UpdateVariationContext on DefaultClient.
+func UpdateVariationContext(ctx context.Context, token, locationID, itemID, variationID string, reqObj *UpdateVariationReqObject) (*ItemVariation, error) {
+	return DefaultClient.UpdateVariationContext(ctx, token, locationID, itemID, variationID, reqObj)
+}
+
+// DeleteVariation calls DeleteVariation on DefaultClient.
+func DeleteVariation(token, locationID, itemID, variationID string) error {
+	return DefaultClient.DeleteVariation(token, locationID, itemID, variationID)
+}
+
+// DeleteVariationContext calls DeleteVariationContext on DefaultClient.
+func DeleteVariationContext(ctx context.Context, token, locationID, itemID, variationID string) error {
+	return DefaultClient.DeleteVariationContext(ctx, token, locationID, itemID, variationID)
+}
+
+// ListInventory calls ListInventory on DefaultClient.
+func ListInventory(token, locationID string, limit int) ([]*InventoryEntry, *NextRequest, error) {
+	return DefaultClient.ListInventory(token, locationID, limit)
+}
+
+// ListInventoryContext calls ListInventoryContext on DefaultClient.
+func ListInventoryContext(ctx context.Context, token, locationID string, limit int) ([]*InventoryEntry, *NextRequest, error) {
+	return DefaultClient.ListInventoryContext(ctx, token, locationID, limit)
+}
+
+// AdjustInventory calls AdjustInventory on DefaultClient.
+func AdjustInventory(token, locationID, variationID string, reqObj *AdjustInventoryReqObject) (*InventoryEntry, error) {
+	return DefaultClient.AdjustInventory(token, locationID, variationID, reqObj)
+}
+
+// AdjustInventoryContext calls AdjustInventoryContext on DefaultClient.
+func AdjustInventoryContext(ctx context.Context, token, locationID, variationID string, reqObj *AdjustInventoryReqObject) (*InventoryEntry, error) {
+	return DefaultClient.AdjustInventoryContext(ctx, token, locationID, variationID, reqObj)
+}
+
+// CreateModifierList calls CreateModifierList on DefaultClient.
+func CreateModifierList(token, locationID string, reqObj *CreateModifierListReqObject) (*ModifierList, error) {
+	return DefaultClient.CreateModifierList(token, locationID, reqObj)
+}
+
+// CreateModifierListContext calls CreateModifierListContext on DefaultClient.
+func CreateModifierListContext(ctx context.Context, token, locationID string, reqObj *CreateModifierListReqObject) (*ModifierList, error) {
+	return DefaultClient.CreateModifierListContext(ctx, token, locationID, reqObj)
+}
+
+// ListModifierLists calls ListModifierLists on DefaultClient.
+func ListModifierLists(token, locationID string) ([]*ModifierList, *NextRequest, error) {
+	return DefaultClient.ListModifierLists(token, locationID)
+}
+
+// ListModifierListsContext calls ListModifierListsContext on DefaultClient.
+func ListModifierListsContext(ctx context.Context, token, locationID string) ([]*ModifierList, *NextRequest, error) {
+	return DefaultClient.ListModifierListsContext(ctx, token, locationID)
+}
+
+// RetrieveModifierList calls RetrieveModifierList on DefaultClient.
+func RetrieveModifierList(token, locationID, modifierListID string) (*ModifierList, error) {
+	return DefaultClient.RetrieveModifierList(token, locationID, modifierListID)
+}
+
+// RetrieveModifierListContext calls RetrieveModifierListContext on DefaultClient.
+func RetrieveModifierListContext(ctx context.Context, token, locationID, modifierListID string) (*ModifierList, error) {
+	return DefaultClient.RetrieveModifierListContext(ctx, token, locationID, modifierListID)
+}
+
+// UpdateModifierList calls UpdateModifierList on DefaultClient.
+func UpdateModifierList(token, locationID, modifierListID string, reqObj *UpdateModifierListReqObject) (*ModifierList, error) {
+	return DefaultClient.UpdateModifierList(token, locationID, modifierListID, reqObj)
+}
+
+// UpdateModifierListContext calls UpdateModifierListContext on DefaultClient.
+func UpdateModifierListContext(ctx context.Context, token, locationID, modifierListID string, reqObj *UpdateModifierListReqObject) (*ModifierList, error) {
+	return DefaultClient.UpdateModifierListContext(ctx, token, locationID, modifierListID, reqObj)
+}
+
+// DeleteModifierList calls DeleteModifierList on DefaultClient.
+func DeleteModifierList(token, locationID, modifierListID string) error {
+	return DefaultClient.DeleteModifierList(token, locationID, modifierListID)
+}
+
+// DeleteModifierListContext calls DeleteModifierListContext on DefaultClient.
+func DeleteModifierListContext(ctx context.Context, token, locationID, modifierListID string) error {
+	return DefaultClient.DeleteModifierListContext(ctx, token, locationID, modifierListID)
+}
+
+// ApplyModifierList calls ApplyModifierList on DefaultClient.
+func ApplyModifierList(token, locationID, itemID, modifierListID string) (*Item, error) {
+	return DefaultClient.ApplyModifierList(token, locationID, itemID, modifierListID)
+}
+
+// ApplyModifierListContext calls ApplyModifierListContext on DefaultClient.
+func ApplyModifierListContext(ctx context.Context, token, locationID, itemID, modifierListID string) (*Item, error) {
+	return DefaultClient.ApplyModifierListContext(ctx, token, locationID, itemID, modifierListID)
+}
+
+// RemoveModifierList calls RemoveModifierList on DefaultClient.
+func RemoveModifierList(token, locationID, itemID, modifierListID string) error {
+	return DefaultClient.RemoveModifierList(token, locationID, itemID, modifierListID)
+}
+
+// RemoveModifierListContext calls RemoveModifierListContext on DefaultClient.
+func RemoveModifierListContext(ctx context.Context, token, locationID, itemID, modifierListID string) error {
+	return DefaultClient.RemoveModifierListContext(ctx, token, locationID, itemID, modifierListID)
+}
+
+// CreateModifierOption calls CreateModifierOption on DefaultClient.
+func CreateModifierOption(token, locationID, modifierListID string, reqObj *CreateModifierOptionReqObject) (*ModifierOption, error) {
+	return DefaultClient.CreateModifierOption(token, locationID, modifierListID, reqObj)
+}
+
+// CreateModifierOptionContext calls CreateModifierOptionContext on DefaultClient.
+func CreateModifierOptionContext(ctx context.Context, token, locationID, modifierListID string, reqObj *CreateModifierOptionReqObject) (*ModifierOption, error) {
+	return DefaultClient.CreateModifierOptionContext(ctx, token, locationID, modifierListID, reqObj)
+}
+
+// UpdateModifierOption calls UpdateModifierOption on DefaultClient.
+func UpdateModifierOption(token, locationID, modifierListID, modifierOptionID string, reqObj *UpdateModifierOptionReqObject) (*ModifierOption, error) {
+	return DefaultClient.UpdateModifierOption(token, locationID, modifierListID, modifierOptionID, reqObj)
+}
+
+// UpdateModifierOptionContext calls UpdateModifierOptionContext on DefaultClient.
+func UpdateModifierOptionContext(ctx context.Context, token, locationID, modifierListID, modifierOptionID string, reqObj *UpdateModifierOptionReqObject) (*ModifierOption, error) {
+	return DefaultClient.UpdateModifierOptionContext(ctx, token, locationID, modifierListID, modifierOptionID, reqObj)
+}
+
+// DeleteModifierOption calls DeleteModifierOption on DefaultClient.
+func DeleteModifierOption(token, locationID, modifierListID, modifierOptionID string) error {
+	return DefaultClient.DeleteModifierOption(token, locationID, modifierListID, modifierOptionID)
+}
+
+// DeleteModifierOptionContext calls DeleteModifierOptionContext on DefaultClient.
+func DeleteModifierOptionContext(ctx context.Context, token, locationID, modifierListID, modifierOptionID string) error {
+	return DefaultClient.DeleteModifierOptionContext(ctx, token, locationID, modifierListID, modifierOptionID)
+}
+
+// CreateCategory calls CreateCategory on DefaultClient.
+func CreateCategory(token, locationID string, reqObj *CreateCategoryReqObject) (*Category, error) {
+	return DefaultClient.CreateCategory(token, locationID, reqObj)
+}
+
+// CreateCategoryContext calls CreateCategoryContext on DefaultClient.
+func CreateCategoryContext(ctx context.Context, token, locationID string, reqObj *CreateCategoryReqObject) (*Category, error) {
+	return DefaultClient.CreateCategoryContext(ctx, token, locationID, reqObj)
+}
+
+// ListCategories calls ListCategories on DefaultClient.
+func ListCategories(token, locationID string) ([]*Category, *NextRequest, error) {
+	return DefaultClient.ListCategories(token, locationID)
+}
+
+// ListCategoriesContext calls ListCategoriesContext on DefaultClient.
+func ListCategoriesContext(ctx context.Context, token, locationID string) ([]*Category, *NextRequest, error) {
+	return DefaultClient.ListCategoriesContext(ctx, token, locationID)
+}
+
+// UpdateCategory calls UpdateCategory on DefaultClient.
+func UpdateCategory(token, locationID, categoryID string, reqObj *UpdateCategoryReqObject) (*Category, error) {
+	return DefaultClient.UpdateCategory(token, locationID, categoryID, reqObj)
+}
+
+// UpdateCategoryContext calls UpdateCategoryContext on DefaultClient.
+func UpdateCategoryContext(ctx context.Context, token, locationID, categoryID string, reqObj *UpdateCategoryReqObject) (*Category, error) {
+	return DefaultClient.UpdateCategoryContext(ctx, token, locationID, categoryID, reqObj)
+}
+
+// DeleteCategory calls DeleteCategory on DefaultClient.
+func DeleteCategory(token, locationID, categoryID string) error {
+	return DefaultClient.DeleteCategory(token, locationID, categoryID)
+}
+
+// DeleteCategoryContext calls DeleteCategoryContext on DefaultClient.
+func DeleteCategoryContext(ctx context.Context, token, locationID, categoryID string) error {
+	return DefaultClient.DeleteCategoryContext(ctx, token, locationID, categoryID)
+}
+
+// CreateDiscount calls CreateDiscount on DefaultClient.
+func CreateDiscount(token, locationID string, reqObj *CreateDiscountReqObject) (*Discount, error) {
+	return DefaultClient.CreateDiscount(token, locationID, reqObj)
+}
+
+// CreateDiscountContext calls CreateDiscountContext on DefaultClient.
+func CreateDiscountContext(ctx context.Context, token, locationID string, reqObj *CreateDiscountReqObject) (*Discount, error) {
+	return DefaultClient.CreateDiscountContext(ctx, token, locationID, reqObj)
+}
+
+// ListDiscounts calls ListDiscounts on DefaultClient.
+func ListDiscounts(token, locationID string) ([]*Discount, *NextRequest, error) {
+	return DefaultClient.ListDiscounts(token, locationID)
+}
+
+// ListDiscountsContext calls ListDiscountsContext on DefaultClient.
+func ListDiscountsContext(ctx context.Context, token, locationID string) ([]*Discount, *NextRequest, error) {
+	return DefaultClient.ListDiscountsContext(ctx, token, locationID)
+}
+
+// UpdateDiscount calls UpdateDiscount on DefaultClient.
+func UpdateDiscount(token, locationID, discountID string, reqObj *UpdateDiscountReqObject) (*Discount, error) {
+	return DefaultClient.UpdateDiscount(token, locationID, discountID, reqObj)
+}
+
+// UpdateDiscountContext calls UpdateDiscountContext on DefaultClient.
+func UpdateDiscountContext(ctx context.Context, token, locationID, discountID string, reqObj *UpdateDiscountReqObject) (*Discount, error) {
+	return DefaultClient.UpdateDiscountContext(ctx, token, locationID, discountID, reqObj)
+}
+
+// DeleteDiscount calls DeleteDiscount on DefaultClient.
+func DeleteDiscount(token, locationID, discountID string) error {
+	return DefaultClient.DeleteDiscount(token, locationID, discountID)
+}
+
+// DeleteDiscountContext calls DeleteDiscountContext on DefaultClient.
+func DeleteDiscountContext(ctx context.Context, token, locationID, discountID string) error {
+	return DefaultClient.DeleteDiscountContext(ctx, token, locationID, discountID)
+}
+
+// CreateFee calls CreateFee on DefaultClient.
+func CreateFee(token, locationID string, reqObj *CreateFeeReqObject) (*Fee, error) {
+	return DefaultClient.CreateFee(token, locationID, reqObj)
+}
+
+// CreateFeeContext calls CreateFeeContext on DefaultClient.
+func CreateFeeContext(ctx context.Context, token, locationID string, reqObj *CreateFeeReqObject) (*Fee, error) {
+	return DefaultClient.CreateFeeContext(ctx, token, locationID, reqObj)
+}
+
+// ListFees calls ListFees on DefaultClient.
+func ListFees(token, locationID string) ([]*Fee, *NextRequest, error) {
+	return DefaultClient.ListFees(token, locationID)
+}
+
+// ListFeesContext calls ListFeesContext on DefaultClient.
+func ListFeesContext(ctx context.Context, token, locationID string) ([]*Fee, *NextRequest, error) {
+	return DefaultClient.ListFeesContext(ctx, token, locationID)
+}
+
+// UpdateFee calls UpdateFee on DefaultClient.
+func UpdateFee(token, locationID, feeID string, reqObj *UpdateFeeReqObject) (*Fee, error) {
+	return DefaultClient.UpdateFee(token, locationID, feeID, reqObj)
+}
+
+// UpdateFeeContext calls UpdateFeeContext on DefaultClient.
+func UpdateFeeContext(ctx context.Context, token, locationID, feeID string, reqObj *UpdateFeeReqObject) (*Fee, error) {
+	return DefaultClient.UpdateFeeContext(ctx, token, locationID, feeID, reqObj)
+}
+
+// DeleteFee calls DeleteFee on DefaultClient.
+func DeleteFee(token, locationID, feeID string) error {
+	return DefaultClient.DeleteFee(token, locationID, feeID)
+}
+
+// DeleteFeeContext calls DeleteFeeContext on DefaultClient.
+func DeleteFeeContext(ctx context.Context, token, locationID, feeID string) error {
+	return DefaultClient.DeleteFeeContext(ctx, token, locationID, feeID)
+}
+
+// ApplyFee calls ApplyFee on DefaultClient.
+func ApplyFee(token, locationID, itemID, feeID string) (*Item, error) {
+	return DefaultClient.ApplyFee(token, locationID, itemID, feeID)
+}
+
+// ApplyFeeContext calls ApplyFeeContext on DefaultClient.
+func ApplyFeeContext(ctx context.Context, token, locationID, itemID, feeID string) (*Item, error) {
+	return DefaultClient.ApplyFeeContext(ctx, token, locationID, itemID, feeID)
+}
+
+// RemoveFee calls RemoveFee on DefaultClient.
+func RemoveFee(token, locationID, itemID, feeID string) error {
+	return DefaultClient.RemoveFee(token, locationID, itemID, feeID)
+}
+
+// RemoveFeeContext calls RemoveFeeContext on DefaultClient.
+func RemoveFeeContext(ctx context.Context, token, locationID, itemID, feeID string) error {
+	return DefaultClient.RemoveFeeContext(ctx, token, locationID, itemID, feeID)
+}
+
+// CreatePage calls CreatePage on DefaultClient.
+func CreatePage(token, locationID string, reqObj *CreatePageReqObject) (*Page, error) {
+	return DefaultClient.CreatePage(token, locationID, reqObj)
+}
+
+// CreatePageContext calls CreatePageContext on DefaultClient.
+func CreatePageContext(ctx context.Context, token, locationID string, reqObj *CreatePageReqObject) (*Page, error) {
+	return DefaultClient.CreatePageContext(ctx, token, locationID, reqObj)
+}
+
+// ListPages calls ListPages on DefaultClient.
+func ListPages(token, locationID string) ([]*Page, *NextRequest, error) {
+	return DefaultClient.ListPages(token, locationID)
+}
+
+// ListPagesContext calls ListPagesContext on DefaultClient.
+func ListPagesContext(ctx context.Context, token, locationID string) ([]*Page, *NextRequest, error) {
+	return DefaultClient.ListPagesContext(ctx, token, locationID)
+}
+
+// UpdatePage calls UpdatePage on DefaultClient.
+func UpdatePage(token, locationID, pageID string, reqObj *UpdatePageReqObject) (*Page, error) {
+	return DefaultClient.UpdatePage(token, locationID, pageID, reqObj)
+}
+
+// UpdatePageContext calls UpdatePageContext on DefaultClient.
+func UpdatePageContext(ctx context.Context, token, locationID, pageID string, reqObj *UpdatePageReqObject) (*Page, error) {
+	return DefaultClient.UpdatePageContext(ctx, token, locationID, pageID, reqObj)
+}
+
+// DeletePage calls DeletePage on DefaultClient.
+func DeletePage(token, locationID, pageID string) error {
+	return DefaultClient.DeletePage(token, locationID, pageID)
+}
+
+// DeletePageContext calls DeletePageContext on DefaultClient.
+func DeletePageContext(ctx context.Context, token, locationID, pageID string) error {
+	return DefaultClient.DeletePageContext(ctx, token, locationID, pageID)
+}
+
+// UpdateCell calls UpdateCell on DefaultClient.
+func UpdateCell(token, locationID, pageID string, reqObj *UpdateCellReqObject) (*PageCell, error) {
+	return DefaultClient.UpdateCell(token, locationID, pageID, reqObj)
+}
+
+// UpdateCellContext calls UpdateCellContext on DefaultClient.
+func UpdateCellContext(ctx context.Context, token, locationID, pageID string, reqObj *UpdateCellReqObject) (*PageCell, error) {
+	return DefaultClient.UpdateCellContext(ctx, token, locationID, pageID, reqObj)
+}
+
+// DeleteCell calls DeleteCell on DefaultClient.
+func DeleteCell(token, locationID, pageID string, row, column int) error {
+	return DefaultClient.DeleteCell(token, locationID, pageID, row, column)
+}
+
+// DeleteCellContext calls DeleteCellContext on DefaultClient.
+func DeleteCellContext(ctx context.Context, token, locationID, pageID string, row, column int) error {
+	return DefaultClient.DeleteCellContext(ctx, token, locationID, pageID, row, column)
+}
+
+// ListWebhooks calls ListWebhooks on DefaultClient.
+func ListWebhooks(token, locationID string) ([]string, *NextRequest, error) {
+	return DefaultClient.ListWebhooks(token, locationID)
+}
+
+// ListWebhooksContext calls ListWebhooksContext on DefaultClient.
+func ListWebhooksContext(ctx context.Context, token, locationID string) ([]string, *NextRequest, error) {
+	return DefaultClient.ListWebhooksContext(ctx, token, locationID)
+}
+
+// UpdateWebhooks calls UpdateWebhooks on DefaultClient.
+//
+// Deprecated: use UpdateWebhooksWithEventTypes instead.
+func UpdateWebhooks(token, locationID string) ([]string, *NextRequest, error) {
+	return DefaultClient.UpdateWebhooks(token, locationID)
+}
+
+// UpdateWebhooksContext calls UpdateWebhooksContext on DefaultClient.
+//
+// Deprecated: use UpdateWebhooksWithEventTypesContext instead.
+func UpdateWebhooksContext(ctx context.Context, token, locationID string) ([]string, *NextRequest, error) {
+	return DefaultClient.UpdateWebhooksContext(ctx, token, locationID)
+}
+
+// UpdateWebhooksWithEventTypes calls UpdateWebhooksWithEventTypes on DefaultClient.
+func UpdateWebhooksWithEventTypes(token, locationID string, eventTypes []string) ([]string, error) {
+	return DefaultClient.UpdateWebhooksWithEventTypes(token, locationID, eventTypes)
+}
+
+// UpdateWebhooksWithEventTypesContext calls UpdateWebhooksWithEventTypesContext on DefaultClient.
+func UpdateWebhooksWithEventTypesContext(ctx context.Context, token, locationID string, eventTypes []string) ([]string, error) {
+	return DefaultClient.UpdateWebhooksWithEventTypesContext(ctx, token, locationID, eventTypes)
+}
+
+// ListSubscriptions calls ListSubscriptions on DefaultClient.
+//
+// Deprecated: use ListSubscriptionsWithOptions, which takes typed filters.
+func ListSubscriptions(token, clientID, merchantID string, limit int) ([]*Subscription, *NextRequest, error) {
+	return DefaultClient.ListSubscriptions(token, clientID, merchantID, limit)
+}
+
+// ListSubscriptionsContext calls ListSubscriptionsContext on DefaultClient.
+//
+// Deprecated: use ListSubscriptionsWithOptionsContext, which takes typed filters.
+func ListSubscriptionsContext(ctx context.Context, token, clientID, merchantID string, limit int) ([]*Subscription, *NextRequest, error) {
+	return DefaultClient.ListSubscriptionsContext(ctx, token, clientID, merchantID, limit)
+}
+
+// ListSubscriptionsWithOptions calls ListSubscriptionsWithOptions on DefaultClient.
+func ListSubscriptionsWithOptions(token, clientID string, opts *ListSubscriptionsOptions) ([]*Subscription, *NextRequest, error) {
+	return DefaultClient.ListSubscriptionsWithOptions(token, clientID, opts)
+}
+
+// ListSubscriptionsWithOptionsContext calls ListSubscriptionsWithOptionsContext on DefaultClient.
+func ListSubscriptionsWithOptionsContext(ctx context.Context, token, clientID string, opts *ListSubscriptionsOptions) ([]*Subscription, *NextRequest, error) {
+	return DefaultClient.ListSubscriptionsWithOptionsContext(ctx, token, clientID, opts)
+}
+
+// RetrieveSubscription calls RetrieveSubscription on DefaultClient.
+func RetrieveSubscription(token, clientID, subscriptionID string) (*Subscription, error) {
+	return DefaultClient.RetrieveSubscription(token, clientID, subscriptionID)
+}
+
+// RetrieveSubscriptionContext calls RetrieveSubscriptionContext on DefaultClient.
+func RetrieveSubscriptionContext(ctx context.Context, token, clientID, subscriptionID string) (*Subscription, error) {
+	return DefaultClient.RetrieveSubscriptionContext(ctx, token, clientID, subscriptionID)
+}
+
+// ListSubscriptionPlans calls ListSubscriptionPlans on DefaultClient.
+func ListSubscriptionPlans(token, clientID string) ([]*SubscriptionPlan, *NextRequest, error) {
+	return DefaultClient.ListSubscriptionPlans(token, clientID)
+}
+
+// ListSubscriptionPlansContext calls ListSubscriptionPlansContext on DefaultClient.
+func ListSubscriptionPlansContext(ctx context.Context, token, clientID string) ([]*SubscriptionPlan, *NextRequest, error) {
+	return DefaultClient.ListSubscriptionPlansContext(ctx, token, clientID)
+}
+
+// RetrieveSubscriptionPlan calls RetrieveSubscriptionPlan on DefaultClient.
+func RetrieveSubscriptionPlan(token, clientID, planID string) (*SubscriptionPlan, error) {
+	return DefaultClient.RetrieveSubscriptionPlan(token, clientID, planID)
+}
+
+// RetrieveSubscriptionPlanContext calls RetrieveSubscriptionPlanContext on DefaultClient.
+func RetrieveSubscriptionPlanContext(ctx context.Context, token, clientID, planID string) (*SubscriptionPlan, error) {
+	return DefaultClient.RetrieveSubscriptionPlanContext(ctx, token, clientID, planID)
+}
+
+// RetrieveBusinessBatchRequest calls RetrieveBusinessBatchRequest on DefaultClient.
+func RetrieveBusinessBatchRequest(token string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveBusinessBatchRequest(token)
+}
+
+// RetrieveBusinessBatchCall calls RetrieveBusinessBatchCall on DefaultClient.
+func RetrieveBusinessBatchCall(token string) *BatchCall[*Merchant] {
+	return DefaultClient.RetrieveBusinessBatchCall(token)
+}
+
+// ListLocationsBatchRequest calls ListLocationsBatchRequest on DefaultClient.
+func ListLocationsBatchRequest(token string) (*BatchRequest, string) {
+	return DefaultClient.ListLocationsBatchRequest(token)
+}
+
+// ListLocationsBatchCall calls ListLocationsBatchCall on DefaultClient.
+func ListLocationsBatchCall(token string) *BatchCall[[]*Merchant] {
+	return DefaultClient.ListLocationsBatchCall(token)
+}
+
+// CreateEmployeeBatchRequest calls CreateEmployeeBatchRequest on DefaultClient.
+func CreateEmployeeBatchRequest(token string, reqObj *CreateEmployeeReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreateEmployeeBatchRequest(token, reqObj)
+}
+
+// CreateEmployeeBatchCall calls CreateEmployeeBatchCall on DefaultClient.
+func CreateEmployeeBatchCall(token string, reqObj *CreateEmployeeReqObject) *BatchCall[*Employee] {
+	return DefaultClient.CreateEmployeeBatchCall(token, reqObj)
+}
+
+// ListEmployeesBatchRequest calls ListEmployeesBatchRequest on DefaultClient.
+//
+// Deprecated: use ListEmployeesWithOptionsBatchRequest, which takes typed filters.
+func ListEmployeesBatchRequest(token, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID string, limit int) (*BatchRequest, string) {
+	return DefaultClient.ListEmployeesBatchRequest(token, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID, limit)
+}
+
+// ListEmployeesWithOptionsBatchRequest calls ListEmployeesWithOptionsBatchRequest on DefaultClient.
+func ListEmployeesWithOptionsBatchRequest(token string, opts *ListEmployeesOptions) (*BatchRequest, string) {
+	return DefaultClient.ListEmployeesWithOptionsBatchRequest(token, opts)
+}
+
+// ListEmployeesWithOptionsBatchCall calls ListEmployeesWithOptionsBatchCall on DefaultClient.
+func ListEmployeesWithOptionsBatchCall(token string, opts *ListEmployeesOptions) *BatchCall[[]*Employee] {
+	return DefaultClient.ListEmployeesWithOptionsBatchCall(token, opts)
+}
+
+// RetrieveEmployeeBatchRequest calls RetrieveEmployeeBatchRequest on DefaultClient.
+func RetrieveEmployeeBatchRequest(token, employeeID string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveEmployeeBatchRequest(token, employeeID)
+}
+
+// RetrieveEmployeeBatchCall calls RetrieveEmployeeBatchCall on DefaultClient.
+func RetrieveEmployeeBatchCall(token, employeeID string) *BatchCall[*Employee] {
+	return DefaultClient.RetrieveEmployeeBatchCall(token, employeeID)
+}
+
+// UpdateEmployeeBatchRequest calls UpdateEmployeeBatchRequest on DefaultClient.
+func UpdateEmployeeBatchRequest(token, employeeID string, reqObj *UpdateEmployeeReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateEmployeeBatchRequest(token, employeeID, reqObj)
+}
+
+// UpdateEmployeeBatchCall calls UpdateEmployeeBatchCall on DefaultClient.
+func UpdateEmployeeBatchCall(token, employeeID string, reqObj *UpdateEmployeeReqObject) *BatchCall[*Employee] {
+	return DefaultClient.UpdateEmployeeBatchCall(token, employeeID, reqObj)
+}
+
+// CreateRoleBatchRequest calls CreateRoleBatchRequest on DefaultClient.
+func CreateRoleBatchRequest(token string, reqObj *CreateRoleReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreateRoleBatchRequest(token, reqObj)
+}
+
+// CreateRoleBatchCall calls CreateRoleBatchCall on DefaultClient.
+func CreateRoleBatchCall(token string, reqObj *CreateRoleReqObject) *BatchCall[*EmployeeRole] {
+	return DefaultClient.CreateRoleBatchCall(token, reqObj)
+}
+
+// ListRolesBatchRequest calls ListRolesBatchRequest on DefaultClient.
+//
+// Deprecated: use ListRolesWithOptionsBatchRequest, which takes typed filters.
+func ListRolesBatchRequest(token, order string, limit int) (*BatchRequest, string) {
+	return DefaultClient.ListRolesBatchRequest(token, order, limit)
+}
+
+// ListRolesWithOptionsBatchRequest calls ListRolesWithOptionsBatchRequest on DefaultClient.
+func ListRolesWithOptionsBatchRequest(token string, opts *ListRolesOptions) (*BatchRequest, string) {
+	return DefaultClient.ListRolesWithOptionsBatchRequest(token, opts)
+}
+
+// ListRolesWithOptionsBatchCall calls ListRolesWithOptionsBatchCall on DefaultClient.
+func ListRolesWithOptionsBatchCall(token string, opts *ListRolesOptions) *BatchCall[[]*EmployeeRole] {
+	return DefaultClient.ListRolesWithOptionsBatchCall(token, opts)
+}
+
+// RetrieveRoleBatchRequest calls RetrieveRoleBatchRequest on DefaultClient.
+func RetrieveRoleBatchRequest(token, roleID string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveRoleBatchRequest(token, roleID)
+}
+
+// RetrieveRoleBatchCall calls RetrieveRoleBatchCall on DefaultClient.
+func RetrieveRoleBatchCall(token, roleID string) *BatchCall[*EmployeeRole] {
+	return DefaultClient.RetrieveRoleBatchCall(token, roleID)
+}
+
+// UpdateRoleBatchRequest calls UpdateRoleBatchRequest on DefaultClient.
+func UpdateRoleBatchRequest(token, roleID string, reqObj *UpdateRoleReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateRoleBatchRequest(token, roleID, reqObj)
+}
+
+// UpdateRoleBatchCall calls UpdateRoleBatchCall on DefaultClient.
+func UpdateRoleBatchCall(token, roleID string, reqObj *UpdateRoleReqObject) *BatchCall[*EmployeeRole] {
+	return DefaultClient.UpdateRoleBatchCall(token, roleID, reqObj)
+}
+
+// CreateTimecardBatchRequest calls CreateTimecardBatchRequest on DefaultClient.
+func CreateTimecardBatchRequest(token string, reqObj *CreateTimecardReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreateTimecardBatchRequest(token, reqObj)
+}
+
+// CreateTimecardBatchCall calls CreateTimecardBatchCall on DefaultClient.
+func CreateTimecardBatchCall(token string, reqObj *CreateTimecardReqObject) *BatchCall[*Timecard] {
+	return DefaultClient.CreateTimecardBatchCall(token, reqObj)
+}
+
+// ListTimecardsBatchRequest calls ListTimecardsBatchRequest on DefaultClient.
+//
+// Deprecated: use ListTimecardsWithOptionsBatchRequest, which takes typed filters.
+func ListTimecardsBatchRequest(token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt string, deleted bool, limit int) (*BatchRequest, string) {
+	return DefaultClient.ListTimecardsBatchRequest(token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt, deleted, limit)
+}
+
+// ListTimecardsWithOptionsBatchRequest calls ListTimecardsWithOptionsBatchRequest on DefaultClient.
+func ListTimecardsWithOptionsBatchRequest(token string, opts *ListTimecardsOptions) (*BatchRequest, string) {
+	return DefaultClient.ListTimecardsWithOptionsBatchRequest(token, opts)
+}
+
+// ListTimecardsWithOptionsBatchCall calls ListTimecardsWithOptionsBatchCall on DefaultClient.
+func ListTimecardsWithOptionsBatchCall(token string, opts *ListTimecardsOptions) *BatchCall[[]*Timecard] {
+	return DefaultClient.ListTimecardsWithOptionsBatchCall(token, opts)
+}
+
+// RetrieveTimecardBatchRequest calls RetrieveTimecardBatchRequest on DefaultClient.
+func RetrieveTimecardBatchRequest(token, timecardID string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveTimecardBatchRequest(token, timecardID)
+}
+
+// RetrieveTimecardBatchCall calls RetrieveTimecardBatchCall on DefaultClient.
+func RetrieveTimecardBatchCall(token, timecardID string) *BatchCall[*Timecard] {
+	return DefaultClient.RetrieveTimecardBatchCall(token, timecardID)
+}
+
+// UpdateTimecardBatchRequest calls UpdateTimecardBatchRequest on DefaultClient.
+func UpdateTimecardBatchRequest(token, timecardID string, reqObj *UpdateTimecardReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateTimecardBatchRequest(token, timecardID, reqObj)
+}
+
+// UpdateTimecardBatchCall calls UpdateTimecardBatchCall on DefaultClient.
+func UpdateTimecardBatchCall(token, timecardID string, reqObj *UpdateTimecardReqObject) *BatchCall[*Timecard] {
+	return DefaultClient.UpdateTimecardBatchCall(token, timecardID, reqObj)
+}
+
+// DeleteTimecardBatchRequest calls DeleteTimecardBatchRequest on DefaultClient.
+func DeleteTimecardBatchRequest(token, timecardID string) (*BatchRequest, string) {
+	return DefaultClient.DeleteTimecardBatchRequest(token, timecardID)
+}
+
+// DeleteTimecardBatchCall calls DeleteTimecardBatchCall on DefaultClient.
+func DeleteTimecardBatchCall(token, timecardID string) *BatchCall[struct{}] {
+	return DefaultClient.DeleteTimecardBatchCall(token, timecardID)
+}
+
+// ListTimecardEventsBatchRequest calls ListTimecardEventsBatchRequest on DefaultClient.
+func ListTimecardEventsBatchRequest(token, timecardID string) (*BatchRequest, string) {
+	return DefaultClient.ListTimecardEventsBatchRequest(token, timecardID)
+}
+
+// ListTimecardEventsBatchCall calls ListTimecardEventsBatchCall on DefaultClient.
+func ListTimecardEventsBatchCall(token, timecardID string) *BatchCall[[]*TimecardEvent] {
+	return DefaultClient.ListTimecardEventsBatchCall(token, timecardID)
+}
+
+// ListCashDrawerShiftsBatchRequest calls ListCashDrawerShiftsBatchRequest on DefaultClient.
+//
+// Deprecated: use ListCashDrawerShiftsWithOptionsBatchRequest, which takes typed filters.
+func ListCashDrawerShiftsBatchRequest(token, locationID, beginTime, endTime, order string) (*BatchRequest, string) {
+	return DefaultClient.ListCashDrawerShiftsBatchRequest(token, locationID, beginTime, endTime, order)
+}
+
+// ListCashDrawerShiftsWithOptionsBatchRequest calls ListCashDrawerShiftsWithOptionsBatchRequest on DefaultClient.
+func ListCashDrawerShiftsWithOptionsBatchRequest(token, locationID string, opts *ListCashDrawerShiftsOptions) (*BatchRequest, string) {
+	return DefaultClient.ListCashDrawerShiftsWithOptionsBatchRequest(token, locationID, opts)
+}
+
+// ListCashDrawerShiftsWithOptionsBatchCall calls ListCashDrawerShiftsWithOptionsBatchCall on DefaultClient.
+func ListCashDrawerShiftsWithOptionsBatchCall(token, locationID string, opts *ListCashDrawerShiftsOptions) *BatchCall[[]*CashDrawerShift] {
+	return DefaultClient.ListCashDrawerShiftsWithOptionsBatchCall(token, locationID, opts)
+}
+
+// RetrieveCashDrawerShiftBatchRequest calls RetrieveCashDrawerShiftBatchRequest on DefaultClient.
+func RetrieveCashDrawerShiftBatchRequest(token, locationID, shiftID string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveCashDrawerShiftBatchRequest(token, locationID, shiftID)
+}
+
+// RetrieveCashDrawerShiftBatchCall calls RetrieveCashDrawerShiftBatchCall on DefaultClient.
+func RetrieveCashDrawerShiftBatchCall(token, locationID, shiftID string) *BatchCall[*CashDrawerShift] {
+	return DefaultClient.RetrieveCashDrawerShiftBatchCall(token, locationID, shiftID)
+}
+
+// ListPaymentsBatchRequest calls ListPaymentsBatchRequest on DefaultClient.
+//
+// Deprecated: use ListPaymentsWithOptionsBatchRequest, which takes typed filters.
+func ListPaymentsBatchRequest(token, locationID, beginTime, endTime, order string, limit int) (*BatchRequest, string) {
+	return DefaultClient.ListPaymentsBatchRequest(token, locationID, beginTime, endTime, order, limit)
+}
+
+// ListPaymentsWithOptionsBatchRequest calls ListPaymentsWithOptionsBatchRequest on DefaultClient.
+func ListPaymentsWithOptionsBatchRequest(token, locationID string, opts *ListPaymentsOptions) (*BatchRequest, string) {
+	return DefaultClient.ListPaymentsWithOptionsBatchRequest(token, locationID, opts)
+}
+
+// ListPaymentsWithOptionsBatchCall calls ListPaymentsWithOptionsBatchCall on DefaultClient.
+func ListPaymentsWithOptionsBatchCall(token, locationID string, opts *ListPaymentsOptions) *BatchCall[[]*Payment] {
+	return DefaultClient.ListPaymentsWithOptionsBatchCall(token, locationID, opts)
+}
+
+// RetrievePaymentBatchRequest calls RetrievePaymentBatchRequest on DefaultClient.
+func RetrievePaymentBatchRequest(token, locationID, paymentID string) (*BatchRequest, string) {
+	return DefaultClient.RetrievePaymentBatchRequest(token, locationID, paymentID)
+}
+
+// RetrievePaymentBatchCall calls RetrievePaymentBatchCall on DefaultClient.
+func RetrievePaymentBatchCall(token, locationID, paymentID string) *BatchCall[*Payment] {
+	return DefaultClient.RetrievePaymentBatchCall(token, locationID, paymentID)
+}
+
+// ListSettlementsBatchRequest calls ListSettlementsBatchRequest on DefaultClient.
+//
+// Deprecated: use ListSettlementsWithOptionsBatchRequest, which takes typed filters.
+func ListSettlementsBatchRequest(token, locationID, beginTime, endTime, order string, limit int, status string) (*BatchRequest, string) {
+	return DefaultClient.ListSettlementsBatchRequest(token, locationID, beginTime, endTime, order, limit, status)
+}
+
+// ListSettlementsWithOptionsBatchRequest calls ListSettlementsWithOptionsBatchRequest on DefaultClient.
+func ListSettlementsWithOptionsBatchRequest(token, locationID string, opts *ListSettlementsOptions) (*BatchRequest, string) {
+	return DefaultClient.ListSettlementsWithOptionsBatchRequest(token, locationID, opts)
+}
+
+// ListSettlementsWithOptionsBatchCall calls ListSettlementsWithOptionsBatchCall on DefaultClient.
+func ListSettlementsWithOptionsBatchCall(token, locationID string, opts *ListSettlementsOptions) *BatchCall[[]*Settlement] {
+	return DefaultClient.ListSettlementsWithOptionsBatchCall(token, locationID, opts)
+}
+
+// RetrieveSettlementBatchRequest calls RetrieveSettlementBatchRequest on DefaultClient.
+func RetrieveSettlementBatchRequest(token, locationID, settlementID string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveSettlementBatchRequest(token, locationID, settlementID)
+}
+
+// RetrieveSettlementBatchCall calls RetrieveSettlementBatchCall on DefaultClient.
+func RetrieveSettlementBatchCall(token, locationID, settlementID string) *BatchCall[*Settlement] {
+	return DefaultClient.RetrieveSettlementBatchCall(token, locationID, settlementID)
+}
+
+// CreateRefundBatchRequest calls CreateRefundBatchRequest on DefaultClient.
+func CreateRefundBatchRequest(token, locationID string, reqObj *CreateRefundReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreateRefundBatchRequest(token, locationID, reqObj)
+}
+
+// CreateRefundBatchCall calls CreateRefundBatchCall on DefaultClient.
+func CreateRefundBatchCall(token, locationID string, reqObj *CreateRefundReqObject) *BatchCall[*Refund] {
+	return DefaultClient.CreateRefundBatchCall(token, locationID, reqObj)
+}
+
+// ListRefundsBatchRequest calls ListRefundsBatchRequest on DefaultClient.
+//
+// Deprecated: use ListRefundsWithOptionsBatchRequest, which takes typed filters.
+func ListRefundsBatchRequest(token, locationID, beginTime, endTime, order string, limit int) (*BatchRequest, string) {
+	return DefaultClient.ListRefundsBatchRequest(token, locationID, beginTime, endTime, order, limit)
+}
+
+// ListRefundsWithOptionsBatchRequest calls ListRefundsWithOptionsBatchRequest on DefaultClient.
+func ListRefundsWithOptionsBatchRequest(token, locationID string, opts *ListRefundsOptions) (*BatchRequest, string) {
+	return DefaultClient.ListRefundsWithOptionsBatchRequest(token, locationID, opts)
+}
+
+// ListRefundsWithOptionsBatchCall calls ListRefundsWithOptionsBatchCall on DefaultClient.
+func ListRefundsWithOptionsBatchCall(token, locationID string, opts *ListRefundsOptions) *BatchCall[[]*Refund] {
+	return DefaultClient.ListRefundsWithOptionsBatchCall(token, locationID, opts)
+}
+
+// ListOrdersBatchRequest calls ListOrdersBatchRequest on DefaultClient.
+//
+// Deprecated: use ListOrdersWithOptionsBatchRequest, which takes typed filters.
+func ListOrdersBatchRequest(token, locationID string, limit int, order string) (*BatchRequest, string) {
+	return DefaultClient.ListOrdersBatchRequest(token, locationID, limit, order)
+}
+
+// ListOrdersWithOptionsBatchRequest calls ListOrdersWithOptionsBatchRequest on DefaultClient.
+func ListOrdersWithOptionsBatchRequest(token, locationID string, opts *ListOrdersOptions) (*BatchRequest, string) {
+	return DefaultClient.ListOrdersWithOptionsBatchRequest(token, locationID, opts)
+}
+
+// ListOrdersWithOptionsBatchCall calls ListOrdersWithOptionsBatchCall on DefaultClient.
+func ListOrdersWithOptionsBatchCall(token, locationID string, opts *ListOrdersOptions) *BatchCall[[]*Order] {
+	return DefaultClient.ListOrdersWithOptionsBatchCall(token, locationID, opts)
+}
+
+// RetrieveOrderBatchRequest calls RetrieveOrderBatchRequest on DefaultClient.
+func RetrieveOrderBatchRequest(token, locationID, orderID string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveOrderBatchRequest(token, locationID, orderID)
+}
+
+// RetrieveOrderBatchCall calls RetrieveOrderBatchCall on DefaultClient.
+func RetrieveOrderBatchCall(token, locationID, orderID string) *BatchCall[*Order] {
+	return DefaultClient.RetrieveOrderBatchCall(token, locationID, orderID)
+}
+
+// UpdateOrderBatchRequest calls UpdateOrderBatchRequest on DefaultClient.
+func UpdateOrderBatchRequest(token, locationID, orderID string, reqObj *UpdateOrderReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateOrderBatchRequest(token, locationID, orderID, reqObj)
+}
+
+// UpdateOrderBatchCall calls UpdateOrderBatchCall on DefaultClient.
+func UpdateOrderBatchCall(token, locationID, orderID string, reqObj *UpdateOrderReqObject) *BatchCall[*Order] {
+	return DefaultClient.UpdateOrderBatchCall(token, locationID, orderID, reqObj)
+}
+
+// ListBankAccountsBatchRequest calls ListBankAccountsBatchRequest on DefaultClient.
+func ListBankAccountsBatchRequest(token, locationID string) (*BatchRequest, string) {
+	return DefaultClient.ListBankAccountsBatchRequest(token, locationID)
+}
+
+// ListBankAccountsBatchCall calls ListBankAccountsBatchCall on DefaultClient.
+func ListBankAccountsBatchCall(token, locationID string) *BatchCall[[]*BankAccount] {
+	return DefaultClient.ListBankAccountsBatchCall(token, locationID)
+}
+
+// RetrieveBankAccountBatchRequest calls RetrieveBankAccountBatchRequest on DefaultClient.
+func RetrieveBankAccountBatchRequest(token, locationID, bankAccountID string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveBankAccountBatchRequest(token, locationID, bankAccountID)
+}
+
+// RetrieveBankAccountBatchCall calls RetrieveBankAccountBatchCall on DefaultClient.
+func RetrieveBankAccountBatchCall(token, locationID, bankAccountID string) *BatchCall[*BankAccount] {
+	return DefaultClient.RetrieveBankAccountBatchCall(token, locationID, bankAccountID)
+}
+
+// CreateItemBatchRequest calls CreateItemBatchRequest on DefaultClient.
+func CreateItemBatchRequest(token, locationID string, reqObj *CreateItemReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreateItemBatchRequest(token, locationID, reqObj)
+}
+
+// CreateItemBatchCall calls CreateItemBatchCall on DefaultClient.
+func CreateItemBatchCall(token, locationID string, reqObj *CreateItemReqObject) *BatchCall[*Item] {
+	return DefaultClient.CreateItemBatchCall(token, locationID, reqObj)
+}
+
+// ListItemsBatchRequest calls ListItemsBatchRequest on DefaultClient.
+func ListItemsBatchRequest(token, locationID string) (*BatchRequest, string) {
+	return DefaultClient.ListItemsBatchRequest(token, locationID)
+}
+
+// ListItemsBatchCall calls ListItemsBatchCall on DefaultClient.
+func ListItemsBatchCall(token, locationID string) *BatchCall[[]*Item] {
+	return DefaultClient.ListItemsBatchCall(token, locationID)
+}
+
+// RetrieveItemBatchRequest calls RetrieveItemBatchRequest on DefaultClient.
+func RetrieveItemBatchRequest(token, locationID, itemID string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveItemBatchRequest(token, locationID, itemID)
+}
+
+// RetrieveItemBatchCall calls RetrieveItemBatchCall on DefaultClient.
+func RetrieveItemBatchCall(token, locationID, itemID string) *BatchCall[*Item] {
+	return DefaultClient.RetrieveItemBatchCall(token, locationID, itemID)
+}
+
+// UpdateItemBatchRequest calls UpdateItemBatchRequest on DefaultClient.
+func UpdateItemBatchRequest(token, locationID, itemID string, reqObj *UpdateItemReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateItemBatchRequest(token, locationID, itemID, reqObj)
+}
+
+// UpdateItemBatchCall calls UpdateItemBatchCall on DefaultClient.
+func UpdateItemBatchCall(token, locationID, itemID string, reqObj *UpdateItemReqObject) *BatchCall[*Item] {
+	return DefaultClient.UpdateItemBatchCall(token, locationID, itemID, reqObj)
+}
+
+// DeleteItemBatchRequest calls DeleteItemBatchRequest on DefaultClient.
+func DeleteItemBatchRequest(token, locationID, itemID string) (*BatchRequest, string) {
+	return DefaultClient.DeleteItemBatchRequest(token, locationID, itemID)
+}
+
+// DeleteItemBatchCall calls DeleteItemBatchCall on DefaultClient.
+func DeleteItemBatchCall(token, locationID, itemID string) *BatchCall[struct{}] {
+	return DefaultClient.DeleteItemBatchCall(token, locationID, itemID)
+}
+
+// CreateVariationBatchRequest calls CreateVariationBatchRequest on DefaultClient.
+func CreateVariationBatchRequest(token, locationID, itemID string, reqObj *CreateVariationReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreateVariationBatchRequest(token, locationID, itemID, reqObj)
+}
+
+// CreateVariationBatchCall calls CreateVariationBatchCall on DefaultClient.
+func CreateVariationBatchCall(token, locationID, itemID string, reqObj *CreateVariationReqObject) *BatchCall[*ItemVariation] {
+	return DefaultClient.CreateVariationBatchCall(token, locationID, itemID, reqObj)
+}
+
+// UpdateVariationBatchRequest calls UpdateVariationBatchRequest on DefaultClient.
+func UpdateVariationBatchRequest(token, locationID, itemID, variationID string, reqObj *UpdateVariationReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateVariationBatchRequest(token, locationID, itemID, variationID, reqObj)
+}
+
+// UpdateVariationBatchCall calls UpdateVariationBatchCall on DefaultClient.
+func UpdateVariationBatchCall(token, locationID, itemID, variationID string, reqObj *UpdateVariationReqObject) *BatchCall[*ItemVariation] {
+	return DefaultClient.UpdateVariationBatchCall(token, locationID, itemID, variationID, reqObj)
+}
+
+// DeleteVariationBatchRequest calls DeleteVariationBatchRequest on DefaultClient.
+func DeleteVariationBatchRequest(token, locationID, itemID, variationID string) (*BatchRequest, string) {
+	return DefaultClient.DeleteVariationBatchRequest(token, locationID, itemID, variationID)
+}
+
+// DeleteVariationBatchCall calls DeleteVariationBatchCall on DefaultClient.
+func DeleteVariationBatchCall(token, locationID, itemID, variationID string) *BatchCall[struct{}] {
+	return DefaultClient.DeleteVariationBatchCall(token, locationID, itemID, variationID)
+}
+
+// ListInventoryBatchRequest calls ListInventoryBatchRequest on DefaultClient.
+func ListInventoryBatchRequest(token, locationID string, limit int) (*BatchRequest, string) {
+	return DefaultClient.ListInventoryBatchRequest(token, locationID, limit)
+}
+
+// ListInventoryBatchCall calls ListInventoryBatchCall on DefaultClient.
+func ListInventoryBatchCall(token, locationID string, limit int) *BatchCall[[]*InventoryEntry] {
+	return DefaultClient.ListInventoryBatchCall(token, locationID, limit)
+}
+
+// AdjustInventoryBatchRequest calls AdjustInventoryBatchRequest on DefaultClient.
+func AdjustInventoryBatchRequest(token, locationID, variationID string, reqObj *AdjustInventoryReqObject) (*BatchRequest, string) {
+	return DefaultClient.AdjustInventoryBatchRequest(token, locationID, variationID, reqObj)
+}
+
+// AdjustInventoryBatchCall calls AdjustInventoryBatchCall on DefaultClient.
+func AdjustInventoryBatchCall(token, locationID, variationID string, reqObj *AdjustInventoryReqObject) *BatchCall[*InventoryEntry] {
+	return DefaultClient.AdjustInventoryBatchCall(token, locationID, variationID, reqObj)
+}
+
+// CreateModifierListBatchRequest calls CreateModifierListBatchRequest on DefaultClient.
+func CreateModifierListBatchRequest(token, locationID string, reqObj *CreateModifierListReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreateModifierListBatchRequest(token, locationID, reqObj)
+}
+
+// CreateModifierListBatchCall calls CreateModifierListBatchCall on DefaultClient.
+func CreateModifierListBatchCall(token, locationID string, reqObj *CreateModifierListReqObject) *BatchCall[*ModifierList] {
+	return DefaultClient.CreateModifierListBatchCall(token, locationID, reqObj)
+}
+
+// ListModifierListsBatchRequest calls ListModifierListsBatchRequest on DefaultClient.
+func ListModifierListsBatchRequest(token, locationID string) (*BatchRequest, string) {
+	return DefaultClient.ListModifierListsBatchRequest(token, locationID)
+}
+
+// ListModifierListsBatchCall calls ListModifierListsBatchCall on DefaultClient.
+func ListModifierListsBatchCall(token, locationID string) *BatchCall[[]*ModifierList] {
+	return DefaultClient.ListModifierListsBatchCall(token, locationID)
+}
+
+// RetrieveModifierListBatchRequest calls RetrieveModifierListBatchRequest on DefaultClient.
+func RetrieveModifierListBatchRequest(token, locationID, modifierListID string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveModifierListBatchRequest(token, locationID, modifierListID)
+}
+
+// RetrieveModifierListBatchCall calls RetrieveModifierListBatchCall on DefaultClient.
+func RetrieveModifierListBatchCall(token, locationID, modifierListID string) *BatchCall[*ModifierList] {
+	return DefaultClient.RetrieveModifierListBatchCall(token, locationID, modifierListID)
+}
+
+// UpdateModifierListBatchRequest calls UpdateModifierListBatchRequest on DefaultClient.
+func UpdateModifierListBatchRequest(token, locationID, modifierListID string, reqObj *UpdateModifierListReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateModifierListBatchRequest(token, locationID, modifierListID, reqObj)
+}
+
+// UpdateModifierListBatchCall calls UpdateModifierListBatchCall on DefaultClient.
+func UpdateModifierListBatchCall(token, locationID, modifierListID string, reqObj *UpdateModifierListReqObject) *BatchCall[*ModifierList] {
+	return DefaultClient.UpdateModifierListBatchCall(token, locationID, modifierListID, reqObj)
+}
+
+// DeleteModifierListBatchRequest calls DeleteModifierListBatchRequest on DefaultClient.
+func DeleteModifierListBatchRequest(token, locationID, modifierListID string) (*BatchRequest, string) {
+	return DefaultClient.DeleteModifierListBatchRequest(token, locationID, modifierListID)
+}
+
+// DeleteModifierListBatchCall calls DeleteModifierListBatchCall on DefaultClient.
+func DeleteModifierListBatchCall(token, locationID, modifierListID string) *BatchCall[struct{}] {
+	return DefaultClient.DeleteModifierListBatchCall(token, locationID, modifierListID)
+}
+
+// ApplyModifierListBatchRequest calls ApplyModifierListBatchRequest on DefaultClient.
+func ApplyModifierListBatchRequest(token, locationID, itemID, modifierListID string) (*BatchRequest, string) {
+	return DefaultClient.ApplyModifierListBatchRequest(token, locationID, itemID, modifierListID)
+}
+
+// ApplyModifierListBatchCall calls ApplyModifierListBatchCall on DefaultClient.
+func ApplyModifierListBatchCall(token, locationID, itemID, modifierListID string) *BatchCall[*Item] {
+	return DefaultClient.ApplyModifierListBatchCall(token, locationID, itemID, modifierListID)
+}
+
+// RemoveModifierListBatchRequest calls RemoveModifierListBatchRequest on DefaultClient.
+func RemoveModifierListBatchRequest(token, locationID, itemID, modifierListID string) (*BatchRequest, string) {
+	return DefaultClient.RemoveModifierListBatchRequest(token, locationID, itemID, modifierListID)
+}
+
+// RemoveModifierListBatchCall calls RemoveModifierListBatchCall on DefaultClient.
+func RemoveModifierListBatchCall(token, locationID, itemID, modifierListID string) *BatchCall[struct{}] {
+	return DefaultClient.RemoveModifierListBatchCall(token, locationID, itemID, modifierListID)
+}
+
+// CreateModifierOptionBatchRequest calls CreateModifierOptionBatchRequest on DefaultClient.
+func CreateModifierOptionBatchRequest(token, locationID, modifierListID string, reqObj *CreateModifierOptionReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreateModifierOptionBatchRequest(token, locationID, modifierListID, reqObj)
+}
+
+// CreateModifierOptionBatchCall calls CreateModifierOptionBatchCall on DefaultClient.
+func CreateModifierOptionBatchCall(token, locationID, modifierListID string, reqObj *CreateModifierOptionReqObject) *BatchCall[*ModifierOption] {
+	return DefaultClient.CreateModifierOptionBatchCall(token, locationID, modifierListID, reqObj)
+}
+
+// UpdateModifierOptionBatchRequest calls UpdateModifierOptionBatchRequest on DefaultClient.
+func UpdateModifierOptionBatchRequest(token, locationID, modifierListID, modifierOptionID string, reqObj *UpdateModifierOptionReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateModifierOptionBatchRequest(token, locationID, modifierListID, modifierOptionID, reqObj)
+}
+
+// UpdateModifierOptionBatchCall calls UpdateModifierOptionBatchCall on DefaultClient.
+func UpdateModifierOptionBatchCall(token, locationID, modifierListID, modifierOptionID string, reqObj *UpdateModifierOptionReqObject) *BatchCall[*ModifierOption] {
+	return DefaultClient.UpdateModifierOptionBatchCall(token, locationID, modifierListID, modifierOptionID, reqObj)
+}
+
+// DeleteModifierOptionBatchRequest calls DeleteModifierOptionBatchRequest on DefaultClient.
+func DeleteModifierOptionBatchRequest(token, locationID, modifierListID, modifierOptionID string) (*BatchRequest, string) {
+	return DefaultClient.DeleteModifierOptionBatchRequest(token, locationID, modifierListID, modifierOptionID)
+}
+
+// DeleteModifierOptionBatchCall calls DeleteModifierOptionBatchCall on DefaultClient.
+func DeleteModifierOptionBatchCall(token, locationID, modifierListID, modifierOptionID string) *BatchCall[struct{}] {
+	return DefaultClient.DeleteModifierOptionBatchCall(token, locationID, modifierListID, modifierOptionID)
+}
+
+// CreateCategoryBatchRequest calls CreateCategoryBatchRequest on DefaultClient.
+func CreateCategoryBatchRequest(token, locationID string, reqObj *CreateCategoryReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreateCategoryBatchRequest(token, locationID, reqObj)
+}
+
+// CreateCategoryBatchCall calls CreateCategoryBatchCall on DefaultClient.
+func CreateCategoryBatchCall(token, locationID string, reqObj *CreateCategoryReqObject) *BatchCall[*Category] {
+	return DefaultClient.CreateCategoryBatchCall(token, locationID, reqObj)
+}
+
+// ListCategoriesBatchRequest calls ListCategoriesBatchRequest on DefaultClient.
+func ListCategoriesBatchRequest(token, locationID string) (*BatchRequest, string) {
+	return DefaultClient.ListCategoriesBatchRequest(token, locationID)
+}
+
+// ListCategoriesBatchCall calls ListCategoriesBatchCall on DefaultClient.
+func ListCategoriesBatchCall(token, locationID string) *BatchCall[[]*Category] {
+	return DefaultClient.ListCategoriesBatchCall(token, locationID)
+}
+
+// UpdateCategoryBatchRequest calls UpdateCategoryBatchRequest on DefaultClient.
+func UpdateCategoryBatchRequest(token, locationID, categoryID string, reqObj *UpdateCategoryReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateCategoryBatchRequest(token, locationID, categoryID, reqObj)
+}
+
+// UpdateCategoryBatchCall calls UpdateCategoryBatchCall on DefaultClient.
+func UpdateCategoryBatchCall(token, locationID, categoryID string, reqObj *UpdateCategoryReqObject) *BatchCall[*Category] {
+	return DefaultClient.UpdateCategoryBatchCall(token, locationID, categoryID, reqObj)
+}
+
+// DeleteCategoryBatchRequest calls DeleteCategoryBatchRequest on DefaultClient.
+func DeleteCategoryBatchRequest(token, locationID, categoryID string) (*BatchRequest, string) {
+	return DefaultClient.DeleteCategoryBatchRequest(token, locationID, categoryID)
+}
+
+// DeleteCategoryBatchCall calls DeleteCategoryBatchCall on DefaultClient.
+func DeleteCategoryBatchCall(token, locationID, categoryID string) *BatchCall[struct{}] {
+	return DefaultClient.DeleteCategoryBatchCall(token, locationID, categoryID)
+}
+
+// CreateDiscountBatchRequest calls CreateDiscountBatchRequest on DefaultClient.
+func CreateDiscountBatchRequest(token, locationID string, reqObj *CreateDiscountReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreateDiscountBatchRequest(token, locationID, reqObj)
+}
+
+// CreateDiscountBatchCall calls CreateDiscountBatchCall on DefaultClient.
+func CreateDiscountBatchCall(token, locationID string, reqObj *CreateDiscountReqObject) *BatchCall[*Discount] {
+	return DefaultClient.CreateDiscountBatchCall(token, locationID, reqObj)
+}
+
+// ListDiscountsBatchRequest calls ListDiscountsBatchRequest on DefaultClient.
+func ListDiscountsBatchRequest(token, locationID string) (*BatchRequest, string) {
+	return DefaultClient.ListDiscountsBatchRequest(token, locationID)
+}
+
+// ListDiscountsBatchCall calls ListDiscountsBatchCall on DefaultClient.
+func ListDiscountsBatchCall(token, locationID string) *BatchCall[[]*Discount] {
+	return DefaultClient.ListDiscountsBatchCall(token, locationID)
+}
+
+// UpdateDiscountBatchRequest calls UpdateDiscountBatchRequest on DefaultClient.
+func UpdateDiscountBatchRequest(token, locationID, discountID string, reqObj *UpdateDiscountReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateDiscountBatchRequest(token, locationID, discountID, reqObj)
+}
+
+// UpdateDiscountBatchCall calls UpdateDiscountBatchCall on DefaultClient.
+func UpdateDiscountBatchCall(token, locationID, discountID string, reqObj *UpdateDiscountReqObject) *BatchCall[*Discount] {
+	return DefaultClient.UpdateDiscountBatchCall(token, locationID, discountID, reqObj)
+}
+
+// DeleteDiscountBatchRequest calls DeleteDiscountBatchRequest on DefaultClient.
+func DeleteDiscountBatchRequest(token, locationID, discountID string) (*BatchRequest, string) {
+	return DefaultClient.DeleteDiscountBatchRequest(token, locationID, discountID)
+}
+
+// DeleteDiscountBatchCall calls DeleteDiscountBatchCall on DefaultClient.
+func DeleteDiscountBatchCall(token, locationID, discountID string) *BatchCall[struct{}] {
+	return DefaultClient.DeleteDiscountBatchCall(token, locationID, discountID)
+}
+
+// CreateFeeBatchRequest calls CreateFeeBatchRequest on DefaultClient.
+func CreateFeeBatchRequest(token, locationID string, reqObj *CreateFeeReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreateFeeBatchRequest(token, locationID, reqObj)
+}
+
+// CreateFeeBatchCall calls CreateFeeBatchCall on DefaultClient.
+func CreateFeeBatchCall(token, locationID string, reqObj *CreateFeeReqObject) *BatchCall[*Fee] {
+	return DefaultClient.CreateFeeBatchCall(token, locationID, reqObj)
+}
+
+// ListFeesBatchRequest calls ListFeesBatchRequest on DefaultClient.
+func ListFeesBatchRequest(token, locationID string) (*BatchRequest, string) {
+	return DefaultClient.ListFeesBatchRequest(token, locationID)
+}
+
+// ListFeesBatchCall calls ListFeesBatchCall on DefaultClient.
+func ListFeesBatchCall(token, locationID string) *BatchCall[[]*Fee] {
+	return DefaultClient.ListFeesBatchCall(token, locationID)
+}
+
+// UpdateFeeBatchRequest calls UpdateFeeBatchRequest on DefaultClient.
+func UpdateFeeBatchRequest(token, locationID, feeID string, reqObj *UpdateFeeReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateFeeBatchRequest(token, locationID, feeID, reqObj)
+}
+
+// UpdateFeeBatchCall calls UpdateFeeBatchCall on DefaultClient.
+func UpdateFeeBatchCall(token, locationID, feeID string, reqObj *UpdateFeeReqObject) *BatchCall[*Fee] {
+	return DefaultClient.UpdateFeeBatchCall(token, locationID, feeID, reqObj)
+}
+
+// DeleteFeeBatchRequest calls DeleteFeeBatchRequest on DefaultClient.
+func DeleteFeeBatchRequest(token, locationID, feeID string) (*BatchRequest, string) {
+	return DefaultClient.DeleteFeeBatchRequest(token, locationID, feeID)
+}
+
+// DeleteFeeBatchCall calls DeleteFeeBatchCall on DefaultClient.
+func DeleteFeeBatchCall(token, locationID, feeID string) *BatchCall[struct{}] {
+	return DefaultClient.DeleteFeeBatchCall(token, locationID, feeID)
+}
+
+// ApplyFeeBatchRequest calls ApplyFeeBatchRequest on DefaultClient.
+func ApplyFeeBatchRequest(token, locationID, itemID, feeID string) (*BatchRequest, string) {
+	return DefaultClient.ApplyFeeBatchRequest(token, locationID, itemID, feeID)
+}
+
+// ApplyFeeBatchCall calls ApplyFeeBatchCall on DefaultClient.
+func ApplyFeeBatchCall(token, locationID, itemID, feeID string) *BatchCall[*Item] {
+	return DefaultClient.ApplyFeeBatchCall(token, locationID, itemID, feeID)
+}
+
+// RemoveFeeBatchRequest calls RemoveFeeBatchRequest on DefaultClient.
+func RemoveFeeBatchRequest(token, locationID, itemID, feeID string) (*BatchRequest, string) {
+	return DefaultClient.RemoveFeeBatchRequest(token, locationID, itemID, feeID)
+}
+
+// RemoveFeeBatchCall calls RemoveFeeBatchCall on DefaultClient.
+func RemoveFeeBatchCall(token, locationID, itemID, feeID string) *BatchCall[struct{}] {
+	return DefaultClient.RemoveFeeBatchCall(token, locationID, itemID, feeID)
+}
+
+// CreatePageBatchRequest calls CreatePageBatchRequest on DefaultClient.
+func CreatePageBatchRequest(token, locationID string, reqObj *CreatePageReqObject) (*BatchRequest, string) {
+	return DefaultClient.CreatePageBatchRequest(token, locationID, reqObj)
+}
+
+// CreatePageBatchCall calls CreatePageBatchCall on DefaultClient.
+func CreatePageBatchCall(token, locationID string, reqObj *CreatePageReqObject) *BatchCall[*Page] {
+	return DefaultClient.CreatePageBatchCall(token, locationID, reqObj)
+}
+
+// ListPagesBatchRequest calls ListPagesBatchRequest on DefaultClient.
+func ListPagesBatchRequest(token, locationID string) (*BatchRequest, string) {
+	return DefaultClient.ListPagesBatchRequest(token, locationID)
+}
+
+// ListPagesBatchCall calls ListPagesBatchCall on DefaultClient.
+func ListPagesBatchCall(token, locationID string) *BatchCall[[]*Page] {
+	return DefaultClient.ListPagesBatchCall(token, locationID)
+}
+
+// UpdatePageBatchRequest calls UpdatePageBatchRequest on DefaultClient.
+func UpdatePageBatchRequest(token, locationID, pageID string, reqObj *UpdatePageReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdatePageBatchRequest(token, locationID, pageID, reqObj)
+}
+
+// UpdatePageBatchCall calls UpdatePageBatchCall on DefaultClient.
+func UpdatePageBatchCall(token, locationID, pageID string, reqObj *UpdatePageReqObject) *BatchCall[*Page] {
+	return DefaultClient.UpdatePageBatchCall(token, locationID, pageID, reqObj)
+}
+
+// DeletePageBatchRequest calls DeletePageBatchRequest on DefaultClient.
+func DeletePageBatchRequest(token, locationID, pageID string) (*BatchRequest, string) {
+	return DefaultClient.DeletePageBatchRequest(token, locationID, pageID)
+}
+
+// DeletePageBatchCall calls DeletePageBatchCall on DefaultClient.
+func DeletePageBatchCall(token, locationID, pageID string) *BatchCall[struct{}] {
+	return DefaultClient.DeletePageBatchCall(token, locationID, pageID)
+}
+
+// UpdateCellBatchRequest calls UpdateCellBatchRequest on DefaultClient.
+func UpdateCellBatchRequest(token, locationID, pageID string, reqObj *UpdateCellReqObject) (*BatchRequest, string) {
+	return DefaultClient.UpdateCellBatchRequest(token, locationID, pageID, reqObj)
+}
+
+// UpdateCellBatchCall calls UpdateCellBatchCall on DefaultClient.
+func UpdateCellBatchCall(token, locationID, pageID string, reqObj *UpdateCellReqObject) *BatchCall[*PageCell] {
+	return DefaultClient.UpdateCellBatchCall(token, locationID, pageID, reqObj)
+}
+
+// DeleteCellBatchRequest calls DeleteCellBatchRequest on DefaultClient.
+func DeleteCellBatchRequest(token, locationID, pageID string, row, column int) (*BatchRequest, string) {
+	return DefaultClient.DeleteCellBatchRequest(token, locationID, pageID, row, column)
+}
+
+// DeleteCellBatchCall calls DeleteCellBatchCall on DefaultClient.
+func DeleteCellBatchCall(token, locationID, pageID string, row, column int) *BatchCall[struct{}] {
+	return DefaultClient.DeleteCellBatchCall(token, locationID, pageID, row, column)
+}
+
+// ListWebhooksBatchRequest calls ListWebhooksBatchRequest on DefaultClient.
+func ListWebhooksBatchRequest(token, locationID string) (*BatchRequest, string) {
+	return DefaultClient.ListWebhooksBatchRequest(token, locationID)
+}
+
+// ListWebhooksBatchCall calls ListWebhooksBatchCall on DefaultClient.
+func ListWebhooksBatchCall(token, locationID string) *BatchCall[[]string] {
+	return DefaultClient.ListWebhooksBatchCall(token, locationID)
+}
+
+// UpdateWebhooksBatchRequest calls UpdateWebhooksBatchRequest on DefaultClient.
+//
+// Deprecated: use UpdateWebhooksWithEventTypesBatchRequest instead.
+func UpdateWebhooksBatchRequest(token, locationID string) (*BatchRequest, string) {
+	return DefaultClient.UpdateWebhooksBatchRequest(token, locationID)
+}
+
+// UpdateWebhooksBatchCall calls UpdateWebhooksBatchCall on DefaultClient.
+//
+// Deprecated: use UpdateWebhooksWithEventTypesBatchCall instead.
+func UpdateWebhooksBatchCall(token, locationID string) *BatchCall[[]string] {
+	return DefaultClient.UpdateWebhooksBatchCall(token, locationID)
+}
+
+// UpdateWebhooksWithEventTypesBatchRequest calls UpdateWebhooksWithEventTypesBatchRequest on DefaultClient.
+func UpdateWebhooksWithEventTypesBatchRequest(token, locationID string, eventTypes []string) (*BatchRequest, string) {
+	return DefaultClient.UpdateWebhooksWithEventTypesBatchRequest(token, locationID, eventTypes)
+}
+
+// UpdateWebhooksWithEventTypesBatchCall calls UpdateWebhooksWithEventTypesBatchCall on DefaultClient.
+func UpdateWebhooksWithEventTypesBatchCall(token, locationID string, eventTypes []string) *BatchCall[[]string] {
+	return DefaultClient.UpdateWebhooksWithEventTypesBatchCall(token, locationID, eventTypes)
+}
+
+// ListSubscriptionsBatchRequest calls ListSubscriptionsBatchRequest on DefaultClient.
+//
+// Deprecated: use ListSubscriptionsWithOptionsBatchRequest, which takes typed filters.
+func ListSubscriptionsBatchRequest(token, clientID, merchantID string, limit int) (*BatchRequest, string) {
+	return DefaultClient.ListSubscriptionsBatchRequest(token, clientID, merchantID, limit)
+}
+
+// ListSubscriptionsWithOptionsBatchRequest calls ListSubscriptionsWithOptionsBatchRequest on DefaultClient.
+func ListSubscriptionsWithOptionsBatchRequest(token, clientID string, opts *ListSubscriptionsOptions) (*BatchRequest, string) {
+	return DefaultClient.ListSubscriptionsWithOptionsBatchRequest(token, clientID, opts)
+}
+
+// ListSubscriptionsWithOptionsBatchCall calls ListSubscriptionsWithOptionsBatchCall on DefaultClient.
+func ListSubscriptionsWithOptionsBatchCall(token, clientID string, opts *ListSubscriptionsOptions) *BatchCall[[]*Subscription] {
+	return DefaultClient.ListSubscriptionsWithOptionsBatchCall(token, clientID, opts)
+}
+
+// RetrieveSubscriptionBatchRequest calls RetrieveSubscriptionBatchRequest on DefaultClient.
+func RetrieveSubscriptionBatchRequest(token, clientID, subscriptionID string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveSubscriptionBatchRequest(token, clientID, subscriptionID)
+}
+
+// RetrieveSubscriptionBatchCall calls RetrieveSubscriptionBatchCall on DefaultClient.
+func RetrieveSubscriptionBatchCall(token, clientID, subscriptionID string) *BatchCall[*Subscription] {
+	return DefaultClient.RetrieveSubscriptionBatchCall(token, clientID, subscriptionID)
+}
+
+// ListSubscriptionPlansBatchRequest calls ListSubscriptionPlansBatchRequest on DefaultClient.
+func ListSubscriptionPlansBatchRequest(token, clientID string) (*BatchRequest, string) {
+	return DefaultClient.ListSubscriptionPlansBatchRequest(token, clientID)
+}
+
+// ListSubscriptionPlansBatchCall calls ListSubscriptionPlansBatchCall on DefaultClient.
+func ListSubscriptionPlansBatchCall(token, clientID string) *BatchCall[[]*SubscriptionPlan] {
+	return DefaultClient.ListSubscriptionPlansBatchCall(token, clientID)
+}
+
+// RetrieveSubscriptionPlanBatchRequest calls RetrieveSubscriptionPlanBatchRequest on DefaultClient.
+func RetrieveSubscriptionPlanBatchRequest(token, clientID, planID string) (*BatchRequest, string) {
+	return DefaultClient.RetrieveSubscriptionPlanBatchRequest(token, clientID, planID)
+}
+
+// RetrieveSubscriptionPlanBatchCall calls RetrieveSubscriptionPlanBatchCall on DefaultClient.
+func RetrieveSubscriptionPlanBatchCall(token, clientID, planID string) *BatchCall[*SubscriptionPlan] {
+	return DefaultClient.RetrieveSubscriptionPlanBatchCall(token, clientID, planID)
+}
+
+// IterLocations calls IterLocations on DefaultClient.
+func IterLocations(ctx context.Context, token string) iter.Seq2[*Merchant, error] {
+	return DefaultClient.IterLocations(ctx, token)
+}
+
+// IterEmployees calls IterEmployees on DefaultClient.
+func IterEmployees(ctx context.Context, token string, opts *ListEmployeesOptions) iter.Seq2[*Employee, error] {
+	return DefaultClient.IterEmployees(ctx, token, opts)
+}
+
+// IterRoles calls IterRoles on DefaultClient.
+func IterRoles(ctx context.Context, token string, opts *ListRolesOptions) iter.Seq2[*EmployeeRole, error] {
+	return DefaultClient.IterRoles(ctx, token, opts)
+}
+
+// IterTimecards calls IterTimecards on DefaultClient.
+func IterTimecards(ctx context.Context, token string, opts *ListTimecardsOptions) iter.Seq2[*Timecard, error] {
+	return DefaultClient.IterTimecards(ctx, token, opts)
+}
+
+// IterTimecardEvents calls IterTimecardEvents on DefaultClient.
+func IterTimecardEvents(ctx context.Context, token, timecardID string) iter.Seq2[*TimecardEvent, error] {
+	return DefaultClient.IterTimecardEvents(ctx, token, timecardID)
+}
+
+// IterCashDrawerShifts calls IterCashDrawerShifts on DefaultClient.
+func IterCashDrawerShifts(ctx context.Context, token, locationID string, opts *ListCashDrawerShiftsOptions) iter.Seq2[*CashDrawerShift, error] {
+	return DefaultClient.IterCashDrawerShifts(ctx, token, locationID, opts)
+}
+
+// IterPayments calls IterPayments on DefaultClient.
+func IterPayments(ctx context.Context, token, locationID string, opts *ListPaymentsOptions) iter.Seq2[*Payment, error] {
+	return DefaultClient.IterPayments(ctx, token, locationID, opts)
+}
+
+// IterSettlements calls IterSettlements on DefaultClient.
+func IterSettlements(ctx context.Context, token, locationID string, opts *ListSettlementsOptions) iter.Seq2[*Settlement, error] {
+	return DefaultClient.IterSettlements(ctx, token, locationID, opts)
+}
+
+// IterRefunds calls IterRefunds on DefaultClient.
+func IterRefunds(ctx context.Context, token, locationID string, opts *ListRefundsOptions) iter.Seq2[*Refund, error] {
+	return DefaultClient.IterRefunds(ctx, token, locationID, opts)
+}
+
+// IterOrders calls IterOrders on DefaultClient.
+func IterOrders(ctx context.Context, token, locationID string, opts *ListOrdersOptions) iter.Seq2[*Order, error] {
+	return DefaultClient.IterOrders(ctx, token, locationID, opts)
+}
+
+// IterBankAccounts calls IterBankAccounts on DefaultClient.
+func IterBankAccounts(ctx context.Context, token, locationID string) iter.Seq2[*BankAccount, error] {
+	return DefaultClient.IterBankAccounts(ctx, token, locationID)
+}
+
+// IterItems calls IterItems on DefaultClient.
+func IterItems(ctx context.Context, token, locationID string) iter.Seq2[*Item, error] {
+	return DefaultClient.IterItems(ctx, token, locationID)
+}
+
+// IterInventory calls IterInventory on DefaultClient.
+func IterInventory(ctx context.Context, token, locationID string, limit int) iter.Seq2[*InventoryEntry, error] {
+	return DefaultClient.IterInventory(ctx, token, locationID, limit)
+}
+
+// IterModifierLists calls IterModifierLists on DefaultClient.
+func IterModifierLists(ctx context.Context, token, locationID string) iter.Seq2[*ModifierList, error] {
+	return DefaultClient.IterModifierLists(ctx, token, locationID)
+}
+
+// IterCategories calls IterCategories on DefaultClient.
+func IterCategories(ctx context.Context, token, locationID string) iter.Seq2[*Category, error] {
+	return DefaultClient.IterCategories(ctx, token, locationID)
+}
+
+// IterDiscounts calls IterDiscounts on DefaultClient.
+func IterDiscounts(ctx context.Context, token, locationID string) iter.Seq2[*Discount, error] {
+	return DefaultClient.IterDiscounts(ctx, token, locationID)
+}
+
+// IterFees calls IterFees on DefaultClient.
+func IterFees(ctx context.Context, token, locationID string) iter.Seq2[*Fee, error] {
+	return DefaultClient.IterFees(ctx, token, locationID)
+}
+
+// IterPages calls IterPages on DefaultClient.
+func IterPages(ctx context.Context, token, locationID string) iter.Seq2[*Page, error] {
+	return DefaultClient.IterPages(ctx, token, locationID)
+}
+
+// IterWebhooks calls IterWebhooks on DefaultClient.
+func IterWebhooks(ctx context.Context, token, locationID string) iter.Seq2[string, error] {
+	return DefaultClient.IterWebhooks(ctx, token, locationID)
+}
+
+// IterSubscriptions calls IterSubscriptions on DefaultClient.
+func IterSubscriptions(ctx context.Context, token, clientID string, opts *ListSubscriptionsOptions) iter.Seq2[*Subscription, error] {
+	return DefaultClient.IterSubscriptions(ctx, token, clientID, opts)
+}
+
+// IterSubscriptionPlans calls IterSubscriptionPlans on DefaultClient.
+func IterSubscriptionPlans(ctx context.Context, token, clientID string) iter.Seq2[*SubscriptionPlan, error] {
+	return DefaultClient.IterSubscriptionPlans(ctx, token, clientID)
+}
+
+// StreamLocations calls StreamLocations on DefaultClient.
+func StreamLocations(ctx context.Context, token string, fn func(*Merchant) error) error {
+	return DefaultClient.StreamLocations(ctx, token, fn)
+}
+
+// StreamEmployees calls StreamEmployees on DefaultClient.
+func StreamEmployees(ctx context.Context, token string, opts *ListEmployeesOptions, fn func(*Employee) error) error {
+	return DefaultClient.StreamEmployees(ctx, token, opts, fn)
+}
+
+// StreamRoles calls StreamRoles on DefaultClient.
+func StreamRoles(ctx context.Context, token string, opts *ListRolesOptions, fn func(*EmployeeRole) error) error {
+	return DefaultClient.StreamRoles(ctx, token, opts, fn)
+}
+
+// StreamTimecards calls StreamTimecards on DefaultClient.
+func StreamTimecards(ctx context.Context, token string, opts *ListTimecardsOptions, fn func(*Timecard) error) error {
+	return DefaultClient.StreamTimecards(ctx, token, opts, fn)
+}
+
+// StreamTimecardEvents calls StreamTimecardEvents on DefaultClient.
+func StreamTimecardEvents(ctx context.Context, token, timecardID string, fn func(*TimecardEvent) error) error {
+	return DefaultClient.StreamTimecardEvents(ctx, token, timecardID, fn)
+}
+
+// StreamCashDrawerShifts calls StreamCashDrawerShifts on DefaultClient.
+func StreamCashDrawerShifts(ctx context.Context, token, locationID string, opts *ListCashDrawerShiftsOptions, fn func(*CashDrawerShift) error) error {
+	return DefaultClient.StreamCashDrawerShifts(ctx, token, locationID, opts, fn)
+}
+
+// StreamPayments calls StreamPayments on DefaultClient.
+func StreamPayments(ctx context.Context, token, locationID string, opts *ListPaymentsOptions, fn func(*Payment) error) error {
+	return DefaultClient.StreamPayments(ctx, token, locationID, opts, fn)
+}
+
+// StreamSettlements calls StreamSettlements on DefaultClient.
+func StreamSettlements(ctx context.Context, token, locationID string, opts *ListSettlementsOptions, fn func(*Settlement) error) error {
+	return DefaultClient.StreamSettlements(ctx, token, locationID, opts, fn)
+}
+
+// StreamRefunds calls StreamRefunds on DefaultClient.
+func StreamRefunds(ctx context.Context, token, locationID string, opts *ListRefundsOptions, fn func(*Refund) error) error {
+	return DefaultClient.StreamRefunds(ctx, token, locationID, opts, fn)
+}
+
+// StreamOrders calls StreamOrders on DefaultClient.
+func StreamOrders(ctx context.Context, token, locationID string, opts *ListOrdersOptions, fn func(*Order) error) error {
+	return DefaultClient.StreamOrders(ctx, token, locationID, opts, fn)
+}
+
+// StreamBankAccounts calls StreamBankAccounts on DefaultClient.
+func StreamBankAccounts(ctx context.Context, token, locationID string, fn func(*BankAccount) error) error {
+	return DefaultClient.StreamBankAccounts(ctx, token, locationID, fn)
+}
+
+// StreamItems calls StreamItems on DefaultClient.
+func StreamItems(ctx context.Context, token, locationID string, fn func(*Item) error) error {
+	return DefaultClient.StreamItems(ctx, token, locationID, fn)
+}
+
+// StreamInventory calls StreamInventory on DefaultClient.
+func StreamInventory(ctx context.Context, token, locationID string, limit int, fn func(*InventoryEntry) error) error {
+	return DefaultClient.StreamInventory(ctx, token, locationID, limit, fn)
+}
+
+// StreamModifierLists calls StreamModifierLists on DefaultClient.
+func StreamModifierLists(ctx context.Context, token, locationID string, fn func(*ModifierList) error) error {
+	return DefaultClient.StreamModifierLists(ctx, token, locationID, fn)
+}
+
+// StreamCategories calls StreamCategories on DefaultClient.
+func StreamCategories(ctx context.Context, token, locationID string, fn func(*Category) error) error {
+	return DefaultClient.StreamCategories(ctx, token, locationID, fn)
+}
+
+// StreamDiscounts calls StreamDiscounts on DefaultClient.
+func StreamDiscounts(ctx context.Context, token, locationID string, fn func(*Discount) error) error {
+	return DefaultClient.StreamDiscounts(ctx, token, locationID, fn)
+}
+
+// StreamFees calls StreamFees on DefaultClient.
+func StreamFees(ctx context.Context, token, locationID string, fn func(*Fee) error) error {
+	return DefaultClient.StreamFees(ctx, token, locationID, fn)
+}
+
+// StreamPages calls StreamPages on DefaultClient.
+func StreamPages(ctx context.Context, token, locationID string, fn func(*Page) error) error {
+	return DefaultClient.StreamPages(ctx, token, locationID, fn)
+}
+
+// StreamWebhooks calls StreamWebhooks on DefaultClient.
+func StreamWebhooks(ctx context.Context, token, locationID string, fn func(string) error) error {
+	return DefaultClient.StreamWebhooks(ctx, token, locationID, fn)
+}
+
+// StreamSubscriptions calls StreamSubscriptions on DefaultClient.
+func StreamSubscriptions(ctx context.Context, token, clientID string, opts *ListSubscriptionsOptions, fn func(*Subscription) error) error {
+	return DefaultClient.StreamSubscriptions(ctx, token, clientID, opts, fn)
+}
+
+// StreamSubscriptionPlans calls StreamSubscriptionPlans on DefaultClient.
+func StreamSubscriptionPlans(ctx context.Context, token, clientID string, fn func(*SubscriptionPlan) error) error {
+	return DefaultClient.StreamSubscriptionPlans(ctx, token, clientID, fn)
+}
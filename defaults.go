@@ -0,0 +1,90 @@
+package gosquare
+
+import (
+	"context"
+	"io"
+)
+
+// The functions in this file and in default_endpoints.go are the original
+// package-level API, kept as thin wrappers over DefaultClient. See the
+// matching Client methods for details.
+
+// GeneratePermissionURL calls GeneratePermissionURL on DefaultClient.
+func GeneratePermissionURL(clientID, scope string, session bool, locale, state string) string {
+	return DefaultClient.GeneratePermissionURL(clientID, scope, session, locale, state)
+}
+
+// GetToken calls GetToken on DefaultClient.
+func GetToken(authorizationCode, applicationID, applicationSecret string) (*Token, error) {
+	return DefaultClient.GetToken(authorizationCode, applicationID, applicationSecret)
+}
+
+// GetTokenContext calls GetTokenContext on DefaultClient.
+func GetTokenContext(ctx context.Context, authorizationCode, applicationID, applicationSecret string) (*Token, error) {
+	return DefaultClient.GetTokenContext(ctx, authorizationCode, applicationID, applicationSecret)
+}
+
+// RenewToken calls RenewToken on DefaultClient.
+func RenewToken(expiredToken, applicationID, applicationSecret string) (*Token, error) {
+	return DefaultClient.RenewToken(expiredToken, applicationID, applicationSecret)
+}
+
+// RenewTokenContext calls RenewTokenContext on DefaultClient.
+func RenewTokenContext(ctx context.Context, expiredToken, applicationID, applicationSecret string) (*Token, error) {
+	return DefaultClient.RenewTokenContext(ctx, expiredToken, applicationID, applicationSecret)
+}
+
+// UploadItemImage calls UploadItemImage on DefaultClient.
+func UploadItemImage(token, locationID, itemID, imageName, imageMime string, body io.Reader) (*ItemImage, error) {
+	return DefaultClient.UploadItemImage(token, locationID, itemID, imageName, imageMime, body)
+}
+
+// UploadItemImageContext calls UploadItemImageContext on DefaultClient.
+func UploadItemImageContext(ctx context.Context, token, locationID, itemID, imageName, imageMime string, body io.Reader) (*ItemImage, error) {
+	return DefaultClient.UploadItemImageContext(ctx, token, locationID, itemID, imageName, imageMime, body)
+}
+
+// SubmitBatch calls SubmitBatch on DefaultClient.
+func SubmitBatch(token string, batchRequests []*BatchRequest) ([]*BatchResponse, error) {
+	return DefaultClient.SubmitBatch(token, batchRequests)
+}
+
+// SubmitBatchContext calls SubmitBatchContext on DefaultClient.
+func SubmitBatchContext(ctx context.Context, token string, batchRequests []*BatchRequest) ([]*BatchResponse, error) {
+	return DefaultClient.SubmitBatchContext(ctx, token, batchRequests)
+}
+
+// SubmitBatchWithOptions calls SubmitBatchWithOptions on DefaultClient.
+func SubmitBatchWithOptions(token string, batchRequests []*BatchRequest, opts *SubmitBatchOptions) ([]*BatchResponse, error) {
+	return DefaultClient.SubmitBatchWithOptions(token, batchRequests, opts)
+}
+
+// SubmitBatchWithOptionsContext calls SubmitBatchWithOptionsContext on DefaultClient.
+func SubmitBatchWithOptionsContext(ctx context.Context, token string, batchRequests []*BatchRequest, opts *SubmitBatchOptions) ([]*BatchResponse, error) {
+	return DefaultClient.SubmitBatchWithOptionsContext(ctx, token, batchRequests, opts)
+}
+
+// ExecuteBatch calls ExecuteBatch on DefaultClient.
+func ExecuteBatch(token string, batchRequests []*BatchRequest, concurrency int) (*BatchResults, error) {
+	return DefaultClient.ExecuteBatch(token, batchRequests, concurrency)
+}
+
+// ExecuteBatchContext calls ExecuteBatchContext on DefaultClient.
+func ExecuteBatchContext(ctx context.Context, token string, batchRequests []*BatchRequest, concurrency int) (*BatchResults, error) {
+	return DefaultClient.ExecuteBatchContext(ctx, token, batchRequests, concurrency)
+}
+
+// ExecuteBatchPlan calls ExecuteBatchPlan on DefaultClient.
+func ExecuteBatchPlan(token string, plan *BatchPlan, concurrency int) ([]*BatchOutcome, error) {
+	return DefaultClient.ExecuteBatchPlan(token, plan, concurrency)
+}
+
+// ExecuteBatchPlanContext calls ExecuteBatchPlanContext on DefaultClient.
+func ExecuteBatchPlanContext(ctx context.Context, token string, plan *BatchPlan, concurrency int) ([]*BatchOutcome, error) {
+	return DefaultClient.ExecuteBatchPlanContext(ctx, token, plan, concurrency)
+}
+
+// ResumeNextRequest calls ResumeNextRequest on DefaultClient.
+func ResumeNextRequest(cursor, token string) (*NextRequest, error) {
+	return DefaultClient.ResumeNextRequest(cursor, token)
+}
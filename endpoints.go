@@ -1,20 +1,23 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
 package gosquare
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"mime/multipart"
-	"net/textproto"
 )
 
 // Provides a business's account information, such as its name and associated email address.
 //
 // Required permissions:  MERCHANT_PROFILE_READ
-func RetrieveBusiness(token string) (*Merchant, error) {
+func (c *Client) RetrieveBusiness(token string) (*Merchant, error) {
+	return c.RetrieveBusinessContext(context.Background(), token)
+}
+
+// RetrieveBusinessContext is like RetrieveBusiness but uses ctx for the request.
+func (c *Client) RetrieveBusinessContext(ctx context.Context, token string) (*Merchant, error) {
 	v := new(Merchant)
-	_, err := squareRequest("GET", "/v1/me", token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveBusiness", "GET", "/v1/me", token, nil, v)
 	if err != nil {
 		return nil, err
 	}
@@ -29,37 +32,31 @@ func RetrieveBusiness(token string) (*Merchant, error) {
 // Square.
 //
 // Required permissions:  MERCHANT_PROFILE_READ
-func ListLocations(token string) ([]*Merchant, *NextRequest, error) {
-	v := make([]*Merchant, 1)
-	nr, err := squareRequest("GET", "/v1/me/locations", token, nil, &v)
+func (c *Client) ListLocations(token string) ([]*Merchant, *NextRequest, error) {
+	return c.ListLocationsContext(context.Background(), token)
+}
+
+// ListLocationsContext is like ListLocations but uses ctx for the request.
+func (c *Client) ListLocationsContext(ctx context.Context, token string) ([]*Merchant, *NextRequest, error) {
+	v := make([]*Merchant, 0)
+	nr, err := c.squareRequest(ctx, "ListLocations", "GET", "/v1/me/locations", token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
 	return v, nr, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreateEmployeeReqObject struct {
-	// The employee's first name.
-	FirstName string `json:"first_name"`
-	// The employee's last name.
-	LastName string `json:"last_name"`
-	// An optional second ID to associate the employee with an entity in another system.
-	ExternalID string `json:"external_id"`
-	// The ids of the employee's associated roles. Currently, you can specify only one
-	// or zero roles per employee.Default value: []
-	RoleIDs []string `json:"role_ids"`
-	// An optional email address to associate with the employee.Note that you cannot edit an existing employee's email address with the Connect API.
-	// You can only set its initial value when creating an employee.
-	Email string `json:"email"`
-}
-
 // Creates an employee for a business.
 //
 // Required permissions:  EMPLOYEES_WRITE
-func CreateEmployee(token string, reqObj *CreateEmployeeReqObject) (*Employee, error) {
+func (c *Client) CreateEmployee(token string, reqObj *CreateEmployeeReqObject) (*Employee, error) {
+	return c.CreateEmployeeContext(context.Background(), token, reqObj)
+}
+
+// CreateEmployeeContext is like CreateEmployee but uses ctx for the request.
+func (c *Client) CreateEmployeeContext(ctx context.Context, token string, reqObj *CreateEmployeeReqObject) (*Employee, error) {
 	v := new(Employee)
-	_, err := squareRequest("POST", "/v1/me/employees", token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreateEmployee", "POST", "/v1/me/employees", token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +65,8 @@ func CreateEmployee(token string, reqObj *CreateEmployeeReqObject) (*Employee, e
 
 // Provides summary information for all of a business's employees.
 //
+// Required permissions:  EMPLOYEES_READ
+//
 // You can filter the results returned by this endpoint by exactly one of the following
 // fields:
 //
@@ -106,9 +105,33 @@ func CreateEmployee(token string, reqObj *CreateEmployeeReqObject) (*Employee, e
 // `limit`:
 // The maximum number of employee entities to return in a single response. This value
 // cannot exceed 200.This value is always an integer.Default value: 100
-func ListEmployees(token string, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID string, limit int) ([]*Employee, *NextRequest, error) {
+//
+// Deprecated: use ListEmployeesWithOptions, which takes typed filters.
+func (c *Client) ListEmployees(token, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID string, limit int) ([]*Employee, *NextRequest, error) {
+	return c.ListEmployeesContext(context.Background(), token, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID, limit)
+}
+
+// ListEmployeesContext is like ListEmployees but uses ctx for the request.
+//
+// Deprecated: use ListEmployeesWithOptionsContext, which takes typed filters.
+func (c *Client) ListEmployeesContext(ctx context.Context, token, order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID string, limit int) ([]*Employee, *NextRequest, error) {
+	return c.listEmployees(ctx, token, listEmployeesPath(order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID, limit))
+}
+
+// ListEmployeesWithOptions is like ListEmployees but takes its filters as
+// a ListEmployeesOptions, nil for none.
+func (c *Client) ListEmployeesWithOptions(token string, opts *ListEmployeesOptions) ([]*Employee, *NextRequest, error) {
+	return c.ListEmployeesWithOptionsContext(context.Background(), token, opts)
+}
+
+// ListEmployeesWithOptionsContext is like ListEmployeesWithOptions but uses ctx for the request.
+func (c *Client) ListEmployeesWithOptionsContext(ctx context.Context, token string, opts *ListEmployeesOptions) ([]*Employee, *NextRequest, error) {
+	return c.listEmployees(ctx, token, opts.path())
+}
+
+func (c *Client) listEmployees(ctx context.Context, token, path string) ([]*Employee, *NextRequest, error) {
 	v := make([]*Employee, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/me/employees?order=%s&begin_updated_at=%s&end_updated_at=%s&begin_created_at=%s&end_created_at=%s&status=%s&external_id=%s&limit=%d", order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID, limit), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListEmployees", "GET", path, token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -118,57 +141,48 @@ func ListEmployees(token string, order, beginUpdatedAt, endUpdatedAt, beginCreat
 // Provides the details for a single employee.
 //
 // Required permissions:  EMPLOYEES_READ
-func RetrieveEmployee(token, employeeID string) (*Employee, error) {
+func (c *Client) RetrieveEmployee(token, employeeID string) (*Employee, error) {
+	return c.RetrieveEmployeeContext(context.Background(), token, employeeID)
+}
+
+// RetrieveEmployeeContext is like RetrieveEmployee but uses ctx for the request.
+func (c *Client) RetrieveEmployeeContext(ctx context.Context, token, employeeID string) (*Employee, error) {
 	v := new(Employee)
-	_, err := squareRequest("GET", fmt.Sprintf("/v1/me/employees/%s", employeeID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveEmployee", "GET", fmt.Sprintf("/v1/me/employees/%s", employeeID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateEmployeeReqObject struct {
-	// The employee's first name.
-	FirstName string `json:"first_name"`
-	// The employee's last name.
-	LastName string `json:"last_name"`
-	// An optional second ID to associate the employee with an entity in another system.
-	ExternalID string `json:"external_id"`
-	// The employee's associated roles. Currently, you can specify only one or zero roles per
-	// employee.
-	RoleIDs []string `json:"role_ids"`
-}
-
 // Modifies the details of an employee.
 //
 // Required permissions:  EMPLOYEES_WRITE
-func UpdateEmployee(token, employeeID string, reqObj *UpdateEmployeeReqObject) (*Employee, error) {
+func (c *Client) UpdateEmployee(token, employeeID string, reqObj *UpdateEmployeeReqObject) (*Employee, error) {
+	return c.UpdateEmployeeContext(context.Background(), token, employeeID, reqObj)
+}
+
+// UpdateEmployeeContext is like UpdateEmployee but uses ctx for the request.
+func (c *Client) UpdateEmployeeContext(ctx context.Context, token, employeeID string, reqObj *UpdateEmployeeReqObject) (*Employee, error) {
 	v := new(Employee)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/me/employees/%s", employeeID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateEmployee", "PUT", fmt.Sprintf("/v1/me/employees/%s", employeeID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreateRoleReqObject struct {
-	// The role's name.
-	Name string `json:"name"`
-	// The role's permissions.
-	Permissions []string `json:"permissions"`
-	// If true, employees with this role have all permissions, regardless of the
-	// values indicated in permissions.Default value: false
-	IsOwner bool `json:"is_owner"`
-}
-
 // Creates an employee role you can then assign to employees.
 //
 // Required permissions:  EMPLOYEES_WRITE
-func CreateRole(token string, reqObj *CreateRoleReqObject) (*EmployeeRole, error) {
+func (c *Client) CreateRole(token string, reqObj *CreateRoleReqObject) (*EmployeeRole, error) {
+	return c.CreateRoleContext(context.Background(), token, reqObj)
+}
+
+// CreateRoleContext is like CreateRole but uses ctx for the request.
+func (c *Client) CreateRoleContext(ctx context.Context, token string, reqObj *CreateRoleReqObject) (*EmployeeRole, error) {
 	v := new(EmployeeRole)
-	_, err := squareRequest("POST", "/v1/me/roles", token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreateRole", "POST", "/v1/me/roles", token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -185,9 +199,33 @@ func CreateRole(token string, reqObj *CreateRoleReqObject) (*EmployeeRole, error
 // `limit`:
 // The maximum number of employee entities to return in a single response. This value
 // cannot exceed 200.This value is always an integer.Default value: 100
-func ListRoles(token, order string, limit int) ([]*EmployeeRole, *NextRequest, error) {
+//
+// Deprecated: use ListRolesWithOptions, which takes typed filters.
+func (c *Client) ListRoles(token, order string, limit int) ([]*EmployeeRole, *NextRequest, error) {
+	return c.ListRolesContext(context.Background(), token, order, limit)
+}
+
+// ListRolesContext is like ListRoles but uses ctx for the request.
+//
+// Deprecated: use ListRolesWithOptionsContext, which takes typed filters.
+func (c *Client) ListRolesContext(ctx context.Context, token, order string, limit int) ([]*EmployeeRole, *NextRequest, error) {
+	return c.listRoles(ctx, token, listRolesPath(order, limit))
+}
+
+// ListRolesWithOptions is like ListRoles but takes its filters as
+// a ListRolesOptions, nil for none.
+func (c *Client) ListRolesWithOptions(token string, opts *ListRolesOptions) ([]*EmployeeRole, *NextRequest, error) {
+	return c.ListRolesWithOptionsContext(context.Background(), token, opts)
+}
+
+// ListRolesWithOptionsContext is like ListRolesWithOptions but uses ctx for the request.
+func (c *Client) ListRolesWithOptionsContext(ctx context.Context, token string, opts *ListRolesOptions) ([]*EmployeeRole, *NextRequest, error) {
+	return c.listRoles(ctx, token, opts.path())
+}
+
+func (c *Client) listRoles(ctx context.Context, token, path string) ([]*EmployeeRole, *NextRequest, error) {
 	v := make([]*EmployeeRole, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/me/roles?order=%s&limit=%d", order, limit), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListRoles", "GET", path, token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -197,62 +235,50 @@ func ListRoles(token, order string, limit int) ([]*EmployeeRole, *NextRequest, e
 // Provides the details for a single employee role.
 //
 // Required permissions:  EMPLOYEES_READ
-func RetrieveRole(token, roleID string) (*EmployeeRole, error) {
+func (c *Client) RetrieveRole(token, roleID string) (*EmployeeRole, error) {
+	return c.RetrieveRoleContext(context.Background(), token, roleID)
+}
+
+// RetrieveRoleContext is like RetrieveRole but uses ctx for the request.
+func (c *Client) RetrieveRoleContext(ctx context.Context, token, roleID string) (*EmployeeRole, error) {
 	v := new(EmployeeRole)
-	_, err := squareRequest("GET", fmt.Sprintf("/v1/me/roles/%s", roleID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveRole", "GET", fmt.Sprintf("/v1/me/roles/%s", roleID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateRoleReqObject struct {
-	// The role's name.
-	Name string `json:"name"`
-	// The role's permissions.
-	Permissions []string `json:"permissions"`
-	// If true, employees with this role have all permissions, regardless of the
-	// values indicated in permissions.
-	IsOwner bool `json:"is_owner"`
-}
-
 // Modifies the details of an employee role.
 //
 // Required permissions:  EMPLOYEES_WRITE
-func UpdateRole(token, roleID string, reqObj *UpdateRoleReqObject) (*EmployeeRole, error) {
+func (c *Client) UpdateRole(token, roleID string, reqObj *UpdateRoleReqObject) (*EmployeeRole, error) {
+	return c.UpdateRoleContext(context.Background(), token, roleID, reqObj)
+}
+
+// UpdateRoleContext is like UpdateRole but uses ctx for the request.
+func (c *Client) UpdateRoleContext(ctx context.Context, token, roleID string, reqObj *UpdateRoleReqObject) (*EmployeeRole, error) {
 	v := new(EmployeeRole)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/me/roles/%s", roleID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateRole", "PUT", fmt.Sprintf("/v1/me/roles/%s", roleID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreateTimecardReqObject struct {
-	// The employee to create a timecard for.
-	EmployeeID string `json:"employee_id"`
-	// The clock-in time for the timecard, in ISO 8601 format.Default value: The current time.
-	ClockinTime string `json:"clockin_time"`
-	// The clock-out time for the timecard, in ISO 8601 format.
-	// Provide this value only if importing timecard information from another system.
-	ClockoutTime string `json:"clockout_time"`
-	// The ID of the location the employee clocked in from, if any.
-	ClockinLocationID string `json:"clockin_location_id"`
-	// The ID of the location the employee clocked out from. Provide this value only if
-	// importing timecard information from another system.If you provide this value, you must also provide a value for clockout_time.
-	ClockoutLocationID string `json:"clockout_location_id"`
-}
-
 // Creates a timecard for an employee. Each timecard corresponds to a single shift.
 //
 // This endpoint automatically creates an API_CREATE event for the new timecard.
 //
 // Required permissions:  TIMECARDS_WRITE
-func CreateTimecard(token string, reqObj *CreateTimecardReqObject) (*Timecard, error) {
+func (c *Client) CreateTimecard(token string, reqObj *CreateTimecardReqObject) (*Timecard, error) {
+	return c.CreateTimecardContext(context.Background(), token, reqObj)
+}
+
+// CreateTimecardContext is like CreateTimecard but uses ctx for the request.
+func (c *Client) CreateTimecardContext(ctx context.Context, token string, reqObj *CreateTimecardReqObject) (*Timecard, error) {
 	v := new(Timecard)
-	_, err := squareRequest("POST", "/v1/me/timecards", token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreateTimecard", "POST", "/v1/me/timecards", token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -261,6 +287,8 @@ func CreateTimecard(token string, reqObj *CreateTimecardReqObject) (*Timecard, e
 
 // Provides summary information for all of a business's employee timecards.
 //
+// Required permissions:  TIMECARDS_READ
+//
 // You can filter the results returned by this endpoint by exactly one of the following
 // fields:
 //
@@ -308,11 +336,33 @@ func CreateTimecard(token string, reqObj *CreateTimecardReqObject) (*Timecard, e
 // `limit`:
 // The maximum number of timecards to return in a single response. This value cannot
 // exceed 200.This value is always an integer.
-func ListTimecards(token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt string, deleted bool, limit int) ([]*Timecard, *NextRequest, error) {
+//
+// Deprecated: use ListTimecardsWithOptions, which takes typed filters.
+func (c *Client) ListTimecards(token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt string, deleted bool, limit int) ([]*Timecard, *NextRequest, error) {
+	return c.ListTimecardsContext(context.Background(), token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt, deleted, limit)
+}
+
+// ListTimecardsContext is like ListTimecards but uses ctx for the request.
+//
+// Deprecated: use ListTimecardsWithOptionsContext, which takes typed filters.
+func (c *Client) ListTimecardsContext(ctx context.Context, token, order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt string, deleted bool, limit int) ([]*Timecard, *NextRequest, error) {
+	return c.listTimecards(ctx, token, listTimecardsPath(order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt, &deleted, limit))
+}
+
+// ListTimecardsWithOptions is like ListTimecards but takes its filters as
+// a ListTimecardsOptions, nil for none.
+func (c *Client) ListTimecardsWithOptions(token string, opts *ListTimecardsOptions) ([]*Timecard, *NextRequest, error) {
+	return c.ListTimecardsWithOptionsContext(context.Background(), token, opts)
+}
+
+// ListTimecardsWithOptionsContext is like ListTimecardsWithOptions but uses ctx for the request.
+func (c *Client) ListTimecardsWithOptionsContext(ctx context.Context, token string, opts *ListTimecardsOptions) ([]*Timecard, *NextRequest, error) {
+	return c.listTimecards(ctx, token, opts.path())
+}
+
+func (c *Client) listTimecards(ctx context.Context, token, path string) ([]*Timecard, *NextRequest, error) {
 	v := make([]*Timecard, 0)
-	nr, err := squareRequest("GET",
-		fmt.Sprintf("/v1/me/timecards?order=%s&employee_id=%s&begin_clockin_time=%s&end_clockin_time=%s&begin_clockout_time=%s&end_clockout_time=%s&begin_updated_at=%s&end_updated_at=%s&deleted=%t&limit=%d",
-			order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt, deleted, limit), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListTimecards", "GET", path, token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -321,44 +371,52 @@ func ListTimecards(token, order, employeeID, beginClockinTime, endClockinTime, b
 
 // Currently, only approved merchants can manage their employees with Square. Unapproved merchants cannot use employee management features you include in your application.
 // Provides the details for a single timecard.
-// Required permissions: TIMECARDS_READ
-func RetrieveTimecard(token, timecardID string) (*Timecard, error) {
+//
+// Required permissions:  TIMECARDS_READ
+func (c *Client) RetrieveTimecard(token, timecardID string) (*Timecard, error) {
+	return c.RetrieveTimecardContext(context.Background(), token, timecardID)
+}
+
+// RetrieveTimecardContext is like RetrieveTimecard but uses ctx for the request.
+func (c *Client) RetrieveTimecardContext(ctx context.Context, token, timecardID string) (*Timecard, error) {
 	v := new(Timecard)
-	_, err := squareRequest("GET", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveTimecard", "GET", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateTimecardReqObject struct {
-	// The clock-in time for the timecard, in ISO 8601 format.
-	ClockinTime string `json:"clockin_time"`
-	// The clock-out time for the timecard, in ISO 8601 format.
-	ClockoutTime string `json:"clockout_time"`
-	// The ID of the location the employee clocked in from, if any.
-	ClockinLocationID string `json:"clockin_location_id"`
-	// The ID of the location the employee clocked out from, if any.
-	ClockoutLocationID string `json:"clockout_location_id"`
-}
-
 // Modifies a timecard's details. This creates an API_EDIT event for the timecard. You
 // can view a timecard's event history with the List Timecard
 // Events endpoint.
 //
 // Required permissions:  TIMECARDS_WRITE
-func UpdateTimecard(token, timecardID string, reqObj *UpdateTimecardReqObject) (*Timecard, error) {
+func (c *Client) UpdateTimecard(token, timecardID string, reqObj *UpdateTimecardReqObject) (*Timecard, error) {
+	return c.UpdateTimecardContext(context.Background(), token, timecardID, reqObj)
+}
+
+// UpdateTimecardContext is like UpdateTimecard but uses ctx for the request.
+func (c *Client) UpdateTimecardContext(ctx context.Context, token, timecardID string, reqObj *UpdateTimecardReqObject) (*Timecard, error) {
 	v := new(Timecard)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateTimecard", "PUT", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-func DeleteTimecard(token, timecardID string) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, nil, nil)
+// Deletes a timecard. Deleted timecards are still accessible from Connect API endpoints, but
+// the value of their deleted field is set to true.
+//
+// Required permissions:  TIMECARDS_WRITE
+func (c *Client) DeleteTimecard(token, timecardID string) error {
+	return c.DeleteTimecardContext(context.Background(), token, timecardID)
+}
+
+// DeleteTimecardContext is like DeleteTimecard but uses ctx for the request.
+func (c *Client) DeleteTimecardContext(ctx context.Context, token, timecardID string) error {
+	_, err := c.squareRequest(ctx, "DeleteTimecard", "DELETE", fmt.Sprintf("/v1/me/timecards/%s", timecardID), token, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -368,9 +426,14 @@ func DeleteTimecard(token, timecardID string) error {
 // Provides summary information for all events associated with a particular timecard.
 //
 // Required permissions:  TIMECARDS_READ
-func ListTimecardEvents(token, timecardID string) ([]*TimecardEvent, *NextRequest, error) {
+func (c *Client) ListTimecardEvents(token, timecardID string) ([]*TimecardEvent, *NextRequest, error) {
+	return c.ListTimecardEventsContext(context.Background(), token, timecardID)
+}
+
+// ListTimecardEventsContext is like ListTimecardEvents but uses ctx for the request.
+func (c *Client) ListTimecardEventsContext(ctx context.Context, token, timecardID string) ([]*TimecardEvent, *NextRequest, error) {
 	v := make([]*TimecardEvent, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/me/timecards/%s/events", timecardID), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListTimecardEvents", "GET", fmt.Sprintf("/v1/me/timecards/%s/events", timecardID), token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -396,9 +459,33 @@ func ListTimecardEvents(token, timecardID string) ([]*TimecardEvent, *NextReques
 // `order`:
 // The order in which cash drawer shifts are listed in the response, based on their
 // created_at field.Default value: ASC
-func ListCashDrawerShifts(token, locationID, beginTime, endTime, order string) ([]*CashDrawerShift, *NextRequest, error) {
+//
+// Deprecated: use ListCashDrawerShiftsWithOptions, which takes typed filters.
+func (c *Client) ListCashDrawerShifts(token, locationID, beginTime, endTime, order string) ([]*CashDrawerShift, *NextRequest, error) {
+	return c.ListCashDrawerShiftsContext(context.Background(), token, locationID, beginTime, endTime, order)
+}
+
+// ListCashDrawerShiftsContext is like ListCashDrawerShifts but uses ctx for the request.
+//
+// Deprecated: use ListCashDrawerShiftsWithOptionsContext, which takes typed filters.
+func (c *Client) ListCashDrawerShiftsContext(ctx context.Context, token, locationID, beginTime, endTime, order string) ([]*CashDrawerShift, *NextRequest, error) {
+	return c.listCashDrawerShifts(ctx, token, listCashDrawerShiftsPath(locationID, beginTime, endTime, order))
+}
+
+// ListCashDrawerShiftsWithOptions is like ListCashDrawerShifts but takes its filters as
+// a ListCashDrawerShiftsOptions, nil for none.
+func (c *Client) ListCashDrawerShiftsWithOptions(token, locationID string, opts *ListCashDrawerShiftsOptions) ([]*CashDrawerShift, *NextRequest, error) {
+	return c.ListCashDrawerShiftsWithOptionsContext(context.Background(), token, locationID, opts)
+}
+
+// ListCashDrawerShiftsWithOptionsContext is like ListCashDrawerShiftsWithOptions but uses ctx for the request.
+func (c *Client) ListCashDrawerShiftsWithOptionsContext(ctx context.Context, token, locationID string, opts *ListCashDrawerShiftsOptions) ([]*CashDrawerShift, *NextRequest, error) {
+	return c.listCashDrawerShifts(ctx, token, opts.path(locationID))
+}
+
+func (c *Client) listCashDrawerShifts(ctx context.Context, token, path string) ([]*CashDrawerShift, *NextRequest, error) {
 	v := make([]*CashDrawerShift, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/cash-drawer-shifts?begin_time=%s&end_time=%s&order=%s", locationID, beginTime, endTime, order), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListCashDrawerShifts", "GET", path, token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -409,9 +496,14 @@ func ListCashDrawerShifts(token, locationID, beginTime, endTime, order string) (
 // during the shift.
 //
 // Required permissions:  PAYMENTS_READ
-func RetrieveCashDrawerShift(token, locationID, shiftID string) (*CashDrawerShift, error) {
+func (c *Client) RetrieveCashDrawerShift(token, locationID, shiftID string) (*CashDrawerShift, error) {
+	return c.RetrieveCashDrawerShiftContext(context.Background(), token, locationID, shiftID)
+}
+
+// RetrieveCashDrawerShiftContext is like RetrieveCashDrawerShift but uses ctx for the request.
+func (c *Client) RetrieveCashDrawerShiftContext(ctx context.Context, token, locationID, shiftID string) (*CashDrawerShift, error) {
 	v := new(CashDrawerShift)
-	_, err := squareRequest("GET", fmt.Sprintf("/v1/%s/cash-drawer-shifts/%s", locationID, shiftID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveCashDrawerShift", "GET", fmt.Sprintf("/v1/%s/cash-drawer-shifts/%s", locationID, shiftID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
@@ -439,9 +531,33 @@ func RetrieveCashDrawerShift(token, locationID, shiftID string) (*CashDrawerShif
 // `limit`:
 // The maximum number of payments to return in a single response. This value cannot exceed
 // 200.This value is always an integer.Default value: 100
-func ListPayments(token, locationID, beginTime, endTime, order string, limit int) ([]*Payment, *NextRequest, error) {
+//
+// Deprecated: use ListPaymentsWithOptions, which takes typed filters.
+func (c *Client) ListPayments(token, locationID, beginTime, endTime, order string, limit int) ([]*Payment, *NextRequest, error) {
+	return c.ListPaymentsContext(context.Background(), token, locationID, beginTime, endTime, order, limit)
+}
+
+// ListPaymentsContext is like ListPayments but uses ctx for the request.
+//
+// Deprecated: use ListPaymentsWithOptionsContext, which takes typed filters.
+func (c *Client) ListPaymentsContext(ctx context.Context, token, locationID, beginTime, endTime, order string, limit int) ([]*Payment, *NextRequest, error) {
+	return c.listPayments(ctx, token, listPaymentsPath(locationID, beginTime, endTime, order, limit))
+}
+
+// ListPaymentsWithOptions is like ListPayments but takes its filters as
+// a ListPaymentsOptions, nil for none.
+func (c *Client) ListPaymentsWithOptions(token, locationID string, opts *ListPaymentsOptions) ([]*Payment, *NextRequest, error) {
+	return c.ListPaymentsWithOptionsContext(context.Background(), token, locationID, opts)
+}
+
+// ListPaymentsWithOptionsContext is like ListPaymentsWithOptions but uses ctx for the request.
+func (c *Client) ListPaymentsWithOptionsContext(ctx context.Context, token, locationID string, opts *ListPaymentsOptions) ([]*Payment, *NextRequest, error) {
+	return c.listPayments(ctx, token, opts.path(locationID))
+}
+
+func (c *Client) listPayments(ctx context.Context, token, path string) ([]*Payment, *NextRequest, error) {
 	v := make([]*Payment, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/payments?begin_time=%s&end_time=%s&order=%s&limit=%d", locationID, beginTime, endTime, order, limit), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListPayments", "GET", path, token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -451,9 +567,14 @@ func ListPayments(token, locationID, beginTime, endTime, order string, limit int
 // Provides comprehensive information for a single payment.
 //
 // Required permissions:  PAYMENTS_READ
-func RetrievePayment(token, locationID, paymentID string) (*Payment, error) {
+func (c *Client) RetrievePayment(token, locationID, paymentID string) (*Payment, error) {
+	return c.RetrievePaymentContext(context.Background(), token, locationID, paymentID)
+}
+
+// RetrievePaymentContext is like RetrievePayment but uses ctx for the request.
+func (c *Client) RetrievePaymentContext(ctx context.Context, token, locationID, paymentID string) (*Payment, error) {
 	v := new(Payment)
-	_, err := squareRequest("GET", fmt.Sprintf("/v1/%s/payments/%s", locationID, paymentID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrievePayment", "GET", fmt.Sprintf("/v1/%s/payments/%s", locationID, paymentID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
@@ -499,9 +620,33 @@ func RetrievePayment(token, locationID, paymentID string) (*Payment, error) {
 // `status`:
 // Provide this parameter to retrieve only settlements with a particular status
 // (SENT or FAILED).
-func ListSettlements(token, locationID, beginTime, endTime, order string, limit int, status string) ([]*Settlement, *NextRequest, error) {
+//
+// Deprecated: use ListSettlementsWithOptions, which takes typed filters.
+func (c *Client) ListSettlements(token, locationID, beginTime, endTime, order string, limit int, status string) ([]*Settlement, *NextRequest, error) {
+	return c.ListSettlementsContext(context.Background(), token, locationID, beginTime, endTime, order, limit, status)
+}
+
+// ListSettlementsContext is like ListSettlements but uses ctx for the request.
+//
+// Deprecated: use ListSettlementsWithOptionsContext, which takes typed filters.
+func (c *Client) ListSettlementsContext(ctx context.Context, token, locationID, beginTime, endTime, order string, limit int, status string) ([]*Settlement, *NextRequest, error) {
+	return c.listSettlements(ctx, token, listSettlementsPath(locationID, beginTime, endTime, order, limit, status))
+}
+
+// ListSettlementsWithOptions is like ListSettlements but takes its filters as
+// a ListSettlementsOptions, nil for none.
+func (c *Client) ListSettlementsWithOptions(token, locationID string, opts *ListSettlementsOptions) ([]*Settlement, *NextRequest, error) {
+	return c.ListSettlementsWithOptionsContext(context.Background(), token, locationID, opts)
+}
+
+// ListSettlementsWithOptionsContext is like ListSettlementsWithOptions but uses ctx for the request.
+func (c *Client) ListSettlementsWithOptionsContext(ctx context.Context, token, locationID string, opts *ListSettlementsOptions) ([]*Settlement, *NextRequest, error) {
+	return c.listSettlements(ctx, token, opts.path(locationID))
+}
+
+func (c *Client) listSettlements(ctx context.Context, token, path string) ([]*Settlement, *NextRequest, error) {
 	v := make([]*Settlement, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/settlements?begin_time=%s&end_time=%s&order=%s&limit=%d&status=%s", locationID, beginTime, endTime, order, limit, status), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListSettlements", "GET", path, token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -515,35 +660,20 @@ func ListSettlements(token, locationID, beginTime, endTime, order string, limit
 // descriptions of the types of entries that compose a settlement.
 //
 // Required permissions:  SETTLEMENTS_READ
-func RetrieveSettlement(token, locationID, settlementID string) (*Settlement, error) {
+func (c *Client) RetrieveSettlement(token, locationID, settlementID string) (*Settlement, error) {
+	return c.RetrieveSettlementContext(context.Background(), token, locationID, settlementID)
+}
+
+// RetrieveSettlementContext is like RetrieveSettlement but uses ctx for the request.
+func (c *Client) RetrieveSettlementContext(ctx context.Context, token, locationID, settlementID string) (*Settlement, error) {
 	v := new(Settlement)
-	_, err := squareRequest("GET", fmt.Sprintf("/v1/%s/settlements/%s", locationID, settlementID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveSettlement", "GET", fmt.Sprintf("/v1/%s/settlements/%s", locationID, settlementID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreateRefundReqObject struct {
-	// The ID of the payment to refund.If you're creating a PARTIAL refund for a split tender payment, instead provide
-	// the id of the particular tender you want to refund. See Split Tender Payments for details.
-	PaymentID string `json:"payment_id"`
-	// The type of refund (FULL or PARTIAL).
-	Type string `json:"type"`
-	// The reason for the refund.
-	Reason string `json:"reason"`
-	// The amount of money to refund. Required only for PARTIAL refunds.The value of amount must be negative.
-	RefundedMoney Money `json:"refunded_money"`
-	// An optional key to ensure idempotence if you issue the same PARTIAL refund
-	// request more than once.If you attempt to issue a partial refund and you aren't sure whether your request
-	// succeeded, you can safely repeat your request with the same
-	// request_idempotence_key. If you want to issue another partial refund for
-	// the same payment, you must use a request_idempotence_key that is unique among
-	// refunds you have issued for the payment.
-	RequestIDempotenceKey string `json:"request_idempotence_key"`
-}
-
 // Issues a refund for a previously processed payment. You must issue a refund within 60 days of
 // the associated payment. See this
 // article for more information on refund behavior.
@@ -555,9 +685,14 @@ type CreateRefundReqObject struct {
 // specify the amount of money to refund.
 //
 // Required permissions:  PAYMENTS_WRITE
-func CreateRefund(token, locationID string, reqObj *CreateRefundReqObject) (*Refund, error) {
+func (c *Client) CreateRefund(token, locationID string, reqObj *CreateRefundReqObject) (*Refund, error) {
+	return c.CreateRefundContext(context.Background(), token, locationID, reqObj)
+}
+
+// CreateRefundContext is like CreateRefund but uses ctx for the request.
+func (c *Client) CreateRefundContext(ctx context.Context, token, locationID string, reqObj *CreateRefundReqObject) (*Refund, error) {
 	v := new(Refund)
-	_, err := squareRequest("POST", fmt.Sprintf("/v1/%s/refunds", locationID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreateRefund", "POST", fmt.Sprintf("/v1/%s/refunds", locationID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -584,15 +719,42 @@ func CreateRefund(token, locationID string, reqObj *CreateRefundReqObject) (*Ref
 // `limit`:
 // The maximum number of refunds to return in a single response. This value cannot exceed
 // 200.This value is always an integer.Default value: 100
-func ListRefunds(token, locationID, beginTime, endTime, order string, limit int) ([]*Refund, *NextRequest, error) {
+//
+// Deprecated: use ListRefundsWithOptions, which takes typed filters.
+func (c *Client) ListRefunds(token, locationID, beginTime, endTime, order string, limit int) ([]*Refund, *NextRequest, error) {
+	return c.ListRefundsContext(context.Background(), token, locationID, beginTime, endTime, order, limit)
+}
+
+// ListRefundsContext is like ListRefunds but uses ctx for the request.
+//
+// Deprecated: use ListRefundsWithOptionsContext, which takes typed filters.
+func (c *Client) ListRefundsContext(ctx context.Context, token, locationID, beginTime, endTime, order string, limit int) ([]*Refund, *NextRequest, error) {
+	return c.listRefunds(ctx, token, listRefundsPath(locationID, beginTime, endTime, order, limit))
+}
+
+// ListRefundsWithOptions is like ListRefunds but takes its filters as
+// a ListRefundsOptions, nil for none.
+func (c *Client) ListRefundsWithOptions(token, locationID string, opts *ListRefundsOptions) ([]*Refund, *NextRequest, error) {
+	return c.ListRefundsWithOptionsContext(context.Background(), token, locationID, opts)
+}
+
+// ListRefundsWithOptionsContext is like ListRefundsWithOptions but uses ctx for the request.
+func (c *Client) ListRefundsWithOptionsContext(ctx context.Context, token, locationID string, opts *ListRefundsOptions) ([]*Refund, *NextRequest, error) {
+	return c.listRefunds(ctx, token, opts.path(locationID))
+}
+
+func (c *Client) listRefunds(ctx context.Context, token, path string) ([]*Refund, *NextRequest, error) {
 	v := make([]*Refund, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/refunds?begin_time=%s&end_time=%s&order=%s&limit=%d", locationID, beginTime, endTime, order, limit), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListRefunds", "GET", path, token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
 	return v, nr, nil
 }
 
+// Provides summary information for a merchant's online store orders.
+//
+// Required permissions:  ORDERS_READ
 //
 // `limit`:
 // The maximum number of orders to return in a single response. This value cannot exceed
@@ -601,46 +763,69 @@ func ListRefunds(token, locationID, beginTime, endTime, order string, limit int)
 // `order`:
 // Indicates whether orders are listed in chronological (ASC) or
 // reverse-chronological (DESC) order.Default value: ASC
-func ListOrders(token, locationID string, limit int, order string) ([]*Order, *NextRequest, error) {
+//
+// Deprecated: use ListOrdersWithOptions, which takes typed filters.
+func (c *Client) ListOrders(token, locationID string, limit int, order string) ([]*Order, *NextRequest, error) {
+	return c.ListOrdersContext(context.Background(), token, locationID, limit, order)
+}
+
+// ListOrdersContext is like ListOrders but uses ctx for the request.
+//
+// Deprecated: use ListOrdersWithOptionsContext, which takes typed filters.
+func (c *Client) ListOrdersContext(ctx context.Context, token, locationID string, limit int, order string) ([]*Order, *NextRequest, error) {
+	return c.listOrders(ctx, token, listOrdersPath(locationID, limit, order))
+}
+
+// ListOrdersWithOptions is like ListOrders but takes its filters as
+// a ListOrdersOptions, nil for none.
+func (c *Client) ListOrdersWithOptions(token, locationID string, opts *ListOrdersOptions) ([]*Order, *NextRequest, error) {
+	return c.ListOrdersWithOptionsContext(context.Background(), token, locationID, opts)
+}
+
+// ListOrdersWithOptionsContext is like ListOrdersWithOptions but uses ctx for the request.
+func (c *Client) ListOrdersWithOptionsContext(ctx context.Context, token, locationID string, opts *ListOrdersOptions) ([]*Order, *NextRequest, error) {
+	return c.listOrders(ctx, token, opts.path(locationID))
+}
+
+func (c *Client) listOrders(ctx context.Context, token, path string) ([]*Order, *NextRequest, error) {
 	v := make([]*Order, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/orders?limit=%d&order=%s", locationID, limit, order), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListOrders", "GET", path, token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
 	return v, nr, nil
 }
 
-func RetrieveOrder(token, locationID, orderID string) (*Order, error) {
+// Provides comprehensive information for a single online store order, including the order's
+// history.
+//
+// Required permissions:  ORDERS_READ
+func (c *Client) RetrieveOrder(token, locationID, orderID string) (*Order, error) {
+	return c.RetrieveOrderContext(context.Background(), token, locationID, orderID)
+}
+
+// RetrieveOrderContext is like RetrieveOrder but uses ctx for the request.
+func (c *Client) RetrieveOrderContext(ctx context.Context, token, locationID, orderID string) (*Order, error) {
 	v := new(Order)
-	_, err := squareRequest("GET", fmt.Sprintf("/v1/%s/orders/%s", locationID, orderID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveOrder", "GET", fmt.Sprintf("/v1/%s/orders/%s", locationID, orderID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateOrderReqObject struct {
-	// The action to perform on the order (COMPLETE, CANCEL, or
-	// REFUND).
-	Action string `json:"action"`
-	// The tracking number of the shipment associated with the order. Only valid if
-	// action is COMPLETE.
-	ShippedTrackingNumber string `json:"shipped_tracking_number"`
-	// A merchant-specified note about the completion of the order. Only valid if
-	// action is COMPLETE.
-	CompletedNote string `json:"completed_note"`
-	// A merchant-specified note about the refunding of the order. Only valid if action
-	// is REFUND.
-	RefundedNote string `json:"refunded_note"`
-	// A merchant-specified note about the canceling of the order. Only valid if action
-	// is CANCEL.
-	CanceledNote string `json:"canceled_note"`
-}
-
-func UpdateOrder(token, locationID, orderID string, reqObj *UpdateOrderReqObject) (*Order, error) {
+// Updates the details of an online store order. Every update you perform on an order
+// corresponds to one of three actions: COMPLETE, CANCEL or REFUND.
+//
+// Required permissions:  ORDERS_WRITE
+func (c *Client) UpdateOrder(token, locationID, orderID string, reqObj *UpdateOrderReqObject) (*Order, error) {
+	return c.UpdateOrderContext(context.Background(), token, locationID, orderID, reqObj)
+}
+
+// UpdateOrderContext is like UpdateOrder but uses ctx for the request.
+func (c *Client) UpdateOrderContext(ctx context.Context, token, locationID, orderID string, reqObj *UpdateOrderReqObject) (*Order, error) {
 	v := new(Order)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/orders/%s", locationID, orderID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateOrder", "PUT", fmt.Sprintf("/v1/%s/orders/%s", locationID, orderID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -652,9 +837,14 @@ func UpdateOrder(token, locationID, orderID string, reqObj *UpdateOrderReqObject
 // full bank account number with the Connect API.
 //
 // Required permissions:  BANK_ACCOUNTS_READ
-func ListBankAccounts(token, locationID string) ([]*BankAccount, *NextRequest, error) {
+func (c *Client) ListBankAccounts(token, locationID string) ([]*BankAccount, *NextRequest, error) {
+	return c.ListBankAccountsContext(context.Background(), token, locationID)
+}
+
+// ListBankAccountsContext is like ListBankAccounts but uses ctx for the request.
+func (c *Client) ListBankAccountsContext(ctx context.Context, token, locationID string) ([]*BankAccount, *NextRequest, error) {
 	v := make([]*BankAccount, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/bank-accounts", locationID), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListBankAccounts", "GET", fmt.Sprintf("/v1/%s/bank-accounts", locationID), token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -666,51 +856,31 @@ func ListBankAccounts(token, locationID string) ([]*BankAccount, *NextRequest, e
 // account number with the Connect API.
 //
 // Required permissions:  BANK_ACCOUNTS_READ
-func RetrieveBankAccount(token, locationID, bankAccountID string) (*BankAccount, error) {
+func (c *Client) RetrieveBankAccount(token, locationID, bankAccountID string) (*BankAccount, error) {
+	return c.RetrieveBankAccountContext(context.Background(), token, locationID, bankAccountID)
+}
+
+// RetrieveBankAccountContext is like RetrieveBankAccount but uses ctx for the request.
+func (c *Client) RetrieveBankAccountContext(ctx context.Context, token, locationID, bankAccountID string) (*BankAccount, error) {
 	v := new(BankAccount)
-	_, err := squareRequest("GET", fmt.Sprintf("/v1/%s/bank-accounts/%s", locationID, bankAccountID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveBankAccount", "GET", fmt.Sprintf("/v1/%s/bank-accounts/%s", locationID, bankAccountID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreateItemReqObject struct {
-	// The item's ID. Must be unique among all entity IDs ever provided on behalf of the
-	// merchant. You can never reuse an ID. This value can include alphanumeric
-	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
-	ID string `json:"id"`
-	// The item's name.
-	Name string `json:"name"`
-	// The item's description.
-	Description string `json:"description"`
-	// The ID of the item's category, if any.
-	CategoryID string `json:"category_id"`
-	// The color of the item's display label in Square Register.Default value: 9da2a6
-	Color string `json:"color"`
-	// The text of the item's display label in Square Register. Only up to the first five
-	// characters of the string are used.Default value: The first two characters of the item's name.
-	Abbreviation string `json:"abbreviation"`
-	// Indicates whether the item is viewable from the merchant's online store (PUBLIC)
-	// or PRIVATE.Default value: PUBLIC
-	Visibility string `json:"visibility"`
-	// If true, the item can be added to shipping orders from the merchant's online
-	// store.Default value: false
-	AvailableOnline bool `json:"available_online"`
-	// If true, the item can be added to pickup orders from the merchant's online
-	// store.Default value: false
-	AvailableForPickup bool `json:"available_for_pickup"`
-	// The item's variations. You must specify at least one variation.
-	Variations []ItemVariation `json:"variations"`
-}
-
 // Creates an item and at least one variation for it.
 //
 // Required permissions:  ITEMS_WRITE
-func CreateItem(token, locationID string, reqObj *CreateItemReqObject) (*Item, error) {
+func (c *Client) CreateItem(token, locationID string, reqObj *CreateItemReqObject) (*Item, error) {
+	return c.CreateItemContext(context.Background(), token, locationID, reqObj)
+}
+
+// CreateItemContext is like CreateItem but uses ctx for the request.
+func (c *Client) CreateItemContext(ctx context.Context, token, locationID string, reqObj *CreateItemReqObject) (*Item, error) {
 	v := new(Item)
-	_, err := squareRequest("POST", fmt.Sprintf("/v1/%s/items", locationID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreateItem", "POST", fmt.Sprintf("/v1/%s/items", locationID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -720,9 +890,14 @@ func CreateItem(token, locationID string, reqObj *CreateItemReqObject) (*Item, e
 // Provides summary information for all of a location's items.
 //
 // Required permissions:  ITEMS_READ
-func ListItems(token, locationID string) ([]*Item, *NextRequest, error) {
+func (c *Client) ListItems(token, locationID string) ([]*Item, *NextRequest, error) {
+	return c.ListItemsContext(context.Background(), token, locationID)
+}
+
+// ListItemsContext is like ListItems but uses ctx for the request.
+func (c *Client) ListItemsContext(ctx context.Context, token, locationID string) ([]*Item, *NextRequest, error) {
 	v := make([]*Item, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/items", locationID), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListItems", "GET", fmt.Sprintf("/v1/%s/items", locationID), token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -732,39 +907,20 @@ func ListItems(token, locationID string) ([]*Item, *NextRequest, error) {
 // Provides the details for a single item, including associated modifier lists and fees.
 //
 // Required permissions:  ITEMS_READ
-func RetrieveItem(token, locationID, itemID string) (*Item, error) {
+func (c *Client) RetrieveItem(token, locationID, itemID string) (*Item, error) {
+	return c.RetrieveItemContext(context.Background(), token, locationID, itemID)
+}
+
+// RetrieveItemContext is like RetrieveItem but uses ctx for the request.
+func (c *Client) RetrieveItemContext(ctx context.Context, token, locationID, itemID string) (*Item, error) {
 	v := new(Item)
-	_, err := squareRequest("GET", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveItem", "GET", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateItemReqObject struct {
-	// The item's name.
-	Name string `json:"name"`
-	// The item's description.
-	Description string `json:"description"`
-	// The ID of the item's category, if any.If you provide the empty string for this value, any existing category association is
-	// removed from the item.
-	CategoryID string `json:"category_id"`
-	// The color of the item's display label in Square Register.
-	Color string `json:"color"`
-	// The text of the item's display label in Square Register. Only up to the first five
-	// characters of the string are used.
-	Abbreviation string `json:"abbreviation"`
-	// Indicates whether the item is viewable from the merchant's online store (PUBLIC) or
-	// PRIVATE.
-	Visibility string `json:"visibility"`
-	// If true, the item can be purchased from the merchant's online store.
-	AvailableOnline bool `json:"available_online"`
-	// If true, the item can be added to pickup orders from the merchant's online
-	// store.
-	AvailableForPickup bool `json:"available_for_pickup"`
-}
-
 // Modifies the core details of an existing item.
 //
 // If you want to modify an item's variations, use the Update Variation endpoint instead.
@@ -775,9 +931,14 @@ type UpdateItemReqObject struct {
 // instead.
 //
 // Required permissions:  ITEMS_WRITE
-func UpdateItem(token, locationID, itemID string, reqObj *UpdateItemReqObject) (*Item, error) {
+func (c *Client) UpdateItem(token, locationID, itemID string, reqObj *UpdateItemReqObject) (*Item, error) {
+	return c.UpdateItemContext(context.Background(), token, locationID, itemID, reqObj)
+}
+
+// UpdateItemContext is like UpdateItem but uses ctx for the request.
+func (c *Client) UpdateItemContext(ctx context.Context, token, locationID, itemID string, reqObj *UpdateItemReqObject) (*Item, error) {
 	v := new(Item)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateItem", "PUT", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -787,127 +948,47 @@ func UpdateItem(token, locationID, itemID string, reqObj *UpdateItemReqObject) (
 // Deletes an existing item and all item variations associated with it.
 //
 // Required permissions:  ITEMS_WRITE
-func DeleteItem(token, locationID, itemID string) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, nil, nil)
+func (c *Client) DeleteItem(token, locationID, itemID string) error {
+	return c.DeleteItemContext(context.Background(), token, locationID, itemID)
+}
+
+// DeleteItemContext is like DeleteItem but uses ctx for the request.
+func (c *Client) DeleteItemContext(ctx context.Context, token, locationID, itemID string) error {
+	_, err := c.squareRequest(ctx, "DeleteItem", "DELETE", fmt.Sprintf("/v1/%s/items/%s", locationID, itemID), token, nil, nil)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// Uploads a JPEG or PNG image and sets it as the master image for an item. See this article for recommended
-// specifications for item images.
-//
-// If you upload an image for an item that already has a master image, the new image replaces
-// the existing one.
-//
-// Important: Requests to this endpoint use the Content-Type: multipart/form-data
-// header instead of Content-Type: application/json. It's recommended that you use an HTTP
-// library (such as Requests for
-// Python) that simplifies the process for sending multipart/form-data requests.
-//
-// The example request body shown assumes that you've set your request's multipart boundary to
-// BOUNDARY in your Content-Type header, like so:
-//
-// Content-Type: multipart/form-data; boundary=BOUNDARY
-//
-// Note that some HTTP libraries set your request's multipart boundary for you.
+// Creates an item variation for an existing item.
 //
 // Required permissions:  ITEMS_WRITE
-func UploadItemImage(token, locationID, itemID, imageName, imageMime string, body io.Reader) (*ItemImage, error) {
-	v := new(ItemImage)
-	b := bytes.NewBuffer(make([]byte, 0))
-	bw := multipart.NewWriter(b)
-	boundary := bw.Boundary()
-	mh := make(textproto.MIMEHeader)
-	mh.Set("Content-Type", imageMime)
-	mh.Set("Content-Disposition", fmt.Sprintf(`form-data; name="image_data"; filename="%s"`, imageName))
-	pw, err := bw.CreatePart(mh)
-	if err != nil {
-		return nil, err
-	}
-	_, err = io.Copy(pw, body)
-	err = bw.Close()
-	if err != nil {
-		return nil, err
-	}
-	_, err = baseSquareRequest("POST", fmt.Sprintf("/v1/%s/items/%s/image", locationID, itemID), token, fmt.Sprintf("multipart/form-data; boundary=%s", boundary), b, v)
-	if err != nil {
-		return nil, err
-	}
-	return v, nil
+func (c *Client) CreateVariation(token, locationID, itemID string, reqObj *CreateVariationReqObject) (*ItemVariation, error) {
+	return c.CreateVariationContext(context.Background(), token, locationID, itemID, reqObj)
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreateVariationReqObject struct {
-	// The variation's ID. Must be unique among all entity IDs ever provided on behalf of the
-	// merchant. You can never reuse an ID. This value can include alphanumeric
-	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
-	ID string `json:"id"`
-	// The item variation's name.
-	Name string `json:"name"`
-	// Indicates whether the item variation's price is fixed or determined at the time of
-	// sale.Default value: FIXED_PRICING
-	PricingType string `json:"pricing_type"`
-	// The item variation's price, if any.
-	PriceMoney Money `json:"price_money"`
-	// The item variation's SKU, if any.
-	Sku string `json:"sku"`
-	// If true, inventory tracking is active for the variation.Default value: false
-	TrackInventory bool `json:"track_inventory"`
-	// Indicates whether the item variation displays an alert when its inventory quantity is
-	// less than or equal to its inventory_alert_threshold.Default value: NONE
-	InventoryAlertType string `json:"inventory_alert_type"`
-	// If the inventory quantity for the variation is less than or equal to this value and
-	// inventory_alert_type is LOW_QUANTITY, the variation displays an alert in
-	// the merchant dashboard.This value is always an integer.Default value: 0
-	InventoryAlertThreshold int `json:"inventory_alert_threshold"`
-	// Arbitrary metadata to associate with the variation. Cannot exceed 255 characters.
-	UserData string `json:"user_data"`
-}
-
-// Creates an item variation for an existing item.
-//
-// Required permissions:  ITEMS_WRITE
-func CreateVariation(token, locationID, itemID string, reqObj *CreateVariationReqObject) (*ItemVariation, error) {
+// CreateVariationContext is like CreateVariation but uses ctx for the request.
+func (c *Client) CreateVariationContext(ctx context.Context, token, locationID, itemID string, reqObj *CreateVariationReqObject) (*ItemVariation, error) {
 	v := new(ItemVariation)
-	_, err := squareRequest("POST", fmt.Sprintf("/v1/%s/items/%s/variations", locationID, itemID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreateVariation", "POST", fmt.Sprintf("/v1/%s/items/%s/variations", locationID, itemID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateVariationReqObject struct {
-	// The item variation's name.
-	Name string `json:"name"`
-	// Indicates whether the item variation's price is fixed or determined at the time of
-	// sale.Default value: FIXED_PRICING
-	PricingType string `json:"pricing_type"`
-	// The item variation's price, if any.
-	PriceMoney Money `json:"price_money"`
-	// The item variation's SKU, if any.
-	Sku string `json:"sku"`
-	// If true, inventory tracking is active for the variation.
-	TrackInventory bool `json:"track_inventory"`
-	// Indicates whether the item variation displays an alert when its inventory quantity goes
-	// below its inventory_alert_threshold.
-	InventoryAlertType string `json:"inventory_alert_type"`
-	// If the inventory quantity for the variation is below this value and
-	// inventory_alert_type is LOW_QUANTITY, the variation displays an alert in
-	// the merchant dashboard.
-	InventoryAlertThreshold int `json:"inventory_alert_threshold"`
-	// Arbitrary metadata to associate with the variation. Cannot exceed 255 characters.
-	UserData string `json:"user_data"`
-}
-
 // Modifies the details of an existing item variation.
 //
 // Required permissions:  ITEMS_WRITE
-func UpdateVariation(token, locationID, itemID, variationID string, reqObj *UpdateVariationReqObject) (*ItemVariation, error) {
+func (c *Client) UpdateVariation(token, locationID, itemID, variationID string, reqObj *UpdateVariationReqObject) (*ItemVariation, error) {
+	return c.UpdateVariationContext(context.Background(), token, locationID, itemID, variationID, reqObj)
+}
+
+// UpdateVariationContext is like UpdateVariation but uses ctx for the request.
+func (c *Client) UpdateVariationContext(ctx context.Context, token, locationID, itemID, variationID string, reqObj *UpdateVariationReqObject) (*ItemVariation, error) {
 	v := new(ItemVariation)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/items/%s/variations/%s", locationID, itemID, variationID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateVariation", "PUT", fmt.Sprintf("/v1/%s/items/%s/variations/%s", locationID, itemID, variationID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -920,8 +1001,13 @@ func UpdateVariation(token, locationID, itemID, variationID string, reqObj *Upda
 // delete an item's only variation.
 //
 // Required permissions:  ITEMS_WRITE
-func DeleteVariation(token, locationID, itemID, variationID string) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/%s/items/%s/variations/%s", locationID, itemID, variationID), token, nil, nil)
+func (c *Client) DeleteVariation(token, locationID, itemID, variationID string) error {
+	return c.DeleteVariationContext(context.Background(), token, locationID, itemID, variationID)
+}
+
+// DeleteVariationContext is like DeleteVariation but uses ctx for the request.
+func (c *Client) DeleteVariationContext(ctx context.Context, token, locationID, itemID, variationID string) error {
+	_, err := c.squareRequest(ctx, "DeleteVariation", "DELETE", fmt.Sprintf("/v1/%s/items/%s/variations/%s", locationID, itemID, variationID), token, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -938,63 +1024,51 @@ func DeleteVariation(token, locationID, itemID, variationID string) error {
 // `limit`:
 // The maximum number of inventory entries to return in a single response. This value
 // cannot exceed 1000.This value is always an integer.Default value: 1000
-func ListInventory(token, locationID string, limit int) ([]*InventoryEntry, *NextRequest, error) {
+func (c *Client) ListInventory(token, locationID string, limit int) ([]*InventoryEntry, *NextRequest, error) {
+	return c.ListInventoryContext(context.Background(), token, locationID, limit)
+}
+
+// ListInventoryContext is like ListInventory but uses ctx for the request.
+func (c *Client) ListInventoryContext(ctx context.Context, token, locationID string, limit int) ([]*InventoryEntry, *NextRequest, error) {
 	v := make([]*InventoryEntry, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/inventory?limit=%d", locationID, limit), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListInventory", "GET", listInventoryPath(locationID, limit), token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
 	return v, nr, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type AdjustInventoryReqObject struct {
-	// The number to adjust the variation's quantity by.This value must be negative if adjustment_type is SALE, and it must be
-	// positive if adjustment_type is RECEIVE_STOCK.
-	QuantityDelta int `json:"quantity_delta"`
-	// The reason for the inventory adjustment.
-	AdjustmentType string `json:"adjustment_type"`
-	// A note about the inventory adjustment.
-	Memo string `json:"memo"`
-}
-
 // Adjusts an item variation's current available inventory.
 //
 // See Managing inventory to learn how to enable an item
 // variation for inventory tracking.
 //
 // Required permissions:  ITEMS_WRITE
-func AdjustInventory(token, locationID, variationID string, reqObj *AdjustInventoryReqObject) (*InventoryEntry, error) {
+func (c *Client) AdjustInventory(token, locationID, variationID string, reqObj *AdjustInventoryReqObject) (*InventoryEntry, error) {
+	return c.AdjustInventoryContext(context.Background(), token, locationID, variationID, reqObj)
+}
+
+// AdjustInventoryContext is like AdjustInventory but uses ctx for the request.
+func (c *Client) AdjustInventoryContext(ctx context.Context, token, locationID, variationID string, reqObj *AdjustInventoryReqObject) (*InventoryEntry, error) {
 	v := new(InventoryEntry)
-	_, err := squareRequest("POST", fmt.Sprintf("/v1/%s/inventory/%s", locationID, variationID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "AdjustInventory", "POST", fmt.Sprintf("/v1/%s/inventory/%s", locationID, variationID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreateModifierListReqObject struct {
-	// The modifier list's ID. Must be unique among all entity IDs ever provided on behalf of
-	// the merchant. You can never reuse an ID. This value can include alphanumeric
-	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
-	ID string `json:"id"`
-	// The modifier list's name.
-	Name string `json:"name"`
-	// Indicates whether multiple options from the modifier list can be applied to a single
-	// item.Default value: SINGLE
-	SelectionType string `json:"selection_type"`
-	// The options included in the modifier list. You must include at least one modifier
-	// option.
-	ModifierOptions []ModifierOption `json:"modifier_options"`
-}
-
 // Creates an item modifier list and at least one modifier option for it.
 //
 // Required permissions:  ITEMS_WRITE
-func CreateModifierList(token, locationID string, reqObj *CreateModifierListReqObject) (*ModifierList, error) {
+func (c *Client) CreateModifierList(token, locationID string, reqObj *CreateModifierListReqObject) (*ModifierList, error) {
+	return c.CreateModifierListContext(context.Background(), token, locationID, reqObj)
+}
+
+// CreateModifierListContext is like CreateModifierList but uses ctx for the request.
+func (c *Client) CreateModifierListContext(ctx context.Context, token, locationID string, reqObj *CreateModifierListReqObject) (*ModifierList, error) {
 	v := new(ModifierList)
-	_, err := squareRequest("POST", fmt.Sprintf("/v1/%s/modifier-lists", locationID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreateModifierList", "POST", fmt.Sprintf("/v1/%s/modifier-lists", locationID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1004,9 +1078,14 @@ func CreateModifierList(token, locationID string, reqObj *CreateModifierListReqO
 // Lists all of a location's modifier lists.
 //
 // Required permissions:  ITEMS_READ
-func ListModifierLists(token, locationID string) ([]*ModifierList, *NextRequest, error) {
+func (c *Client) ListModifierLists(token, locationID string) ([]*ModifierList, *NextRequest, error) {
+	return c.ListModifierListsContext(context.Background(), token, locationID)
+}
+
+// ListModifierListsContext is like ListModifierLists but uses ctx for the request.
+func (c *Client) ListModifierListsContext(ctx context.Context, token, locationID string) ([]*ModifierList, *NextRequest, error) {
 	v := make([]*ModifierList, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/modifier-lists", locationID), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListModifierLists", "GET", fmt.Sprintf("/v1/%s/modifier-lists", locationID), token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1016,32 +1095,33 @@ func ListModifierLists(token, locationID string) ([]*ModifierList, *NextRequest,
 // Provides the details for a single modifier list.
 //
 // Required permissions:  ITEMS_READ
-func RetrieveModifierList(token, locationID, modifierListID string) (*ModifierList, error) {
+func (c *Client) RetrieveModifierList(token, locationID, modifierListID string) (*ModifierList, error) {
+	return c.RetrieveModifierListContext(context.Background(), token, locationID, modifierListID)
+}
+
+// RetrieveModifierListContext is like RetrieveModifierList but uses ctx for the request.
+func (c *Client) RetrieveModifierListContext(ctx context.Context, token, locationID, modifierListID string) (*ModifierList, error) {
 	v := new(ModifierList)
-	_, err := squareRequest("GET", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveModifierList", "GET", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateModifierListReqObject struct {
-	// The modifier list's name.
-	Name string `json:"name"`
-	// Indicates whether multiple options from the modifier list can be applied to a single
-	// item.
-	SelectionType string `json:"selection_type"`
-}
-
 // Modifies the details of an existing item modifier list.
 //
 // If you want to modify the details of a single modifier option, use the Update Modifier Option endpoint instead.
 //
 // Required permissions:  ITEMS_WRITE
-func UpdateModifierList(token, locationID, modifierListID string, reqObj *UpdateModifierListReqObject) (*ModifierList, error) {
+func (c *Client) UpdateModifierList(token, locationID, modifierListID string, reqObj *UpdateModifierListReqObject) (*ModifierList, error) {
+	return c.UpdateModifierListContext(context.Background(), token, locationID, modifierListID, reqObj)
+}
+
+// UpdateModifierListContext is like UpdateModifierList but uses ctx for the request.
+func (c *Client) UpdateModifierListContext(ctx context.Context, token, locationID, modifierListID string, reqObj *UpdateModifierListReqObject) (*ModifierList, error) {
 	v := new(ModifierList)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateModifierList", "PUT", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1051,8 +1131,13 @@ func UpdateModifierList(token, locationID, modifierListID string, reqObj *Update
 // Deletes an existing item modifier list and all modifier options associated with it.
 //
 // Required permissions:  ITEMS_WRITE
-func DeleteModifierList(token, locationID, modifierListID string) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, nil, nil)
+func (c *Client) DeleteModifierList(token, locationID, modifierListID string) error {
+	return c.DeleteModifierListContext(context.Background(), token, locationID, modifierListID)
+}
+
+// DeleteModifierListContext is like DeleteModifierList but uses ctx for the request.
+func (c *Client) DeleteModifierListContext(ctx context.Context, token, locationID, modifierListID string) error {
+	_, err := c.squareRequest(ctx, "DeleteModifierList", "DELETE", fmt.Sprintf("/v1/%s/modifier-lists/%s", locationID, modifierListID), token, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -1063,9 +1148,14 @@ func DeleteModifierList(token, locationID, modifierListID string) error {
 // applied to the item.
 //
 // Required permissions:  ITEMS_WRITE
-func ApplyModifierList(token, locationID, itemID, modifierListID string) (*Item, error) {
+func (c *Client) ApplyModifierList(token, locationID, itemID, modifierListID string) (*Item, error) {
+	return c.ApplyModifierListContext(context.Background(), token, locationID, itemID, modifierListID)
+}
+
+// ApplyModifierListContext is like ApplyModifierList but uses ctx for the request.
+func (c *Client) ApplyModifierListContext(ctx context.Context, token, locationID, itemID, modifierListID string) (*Item, error) {
 	v := new(Item)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/items/%s/modifier-lists/%s", locationID, itemID, modifierListID), token, nil, v)
+	_, err := c.squareRequest(ctx, "ApplyModifierList", "PUT", fmt.Sprintf("/v1/%s/items/%s/modifier-lists/%s", locationID, itemID, modifierListID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1076,58 +1166,47 @@ func ApplyModifierList(token, locationID, itemID, modifierListID string) (*Item,
 // no longer be applied to the item.
 //
 // Required permissions:  ITEMS_WRITE
-func RemoveModifierList(token, locationID, itemID, modifierListID string) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/%s/items/%s/modifier-lists/%s", locationID, itemID, modifierListID), token, nil, nil)
+func (c *Client) RemoveModifierList(token, locationID, itemID, modifierListID string) error {
+	return c.RemoveModifierListContext(context.Background(), token, locationID, itemID, modifierListID)
+}
+
+// RemoveModifierListContext is like RemoveModifierList but uses ctx for the request.
+func (c *Client) RemoveModifierListContext(ctx context.Context, token, locationID, itemID, modifierListID string) error {
+	_, err := c.squareRequest(ctx, "RemoveModifierList", "DELETE", fmt.Sprintf("/v1/%s/items/%s/modifier-lists/%s", locationID, itemID, modifierListID), token, nil, nil)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreateModifierOptionReqObject struct {
-	// The modifier option's ID. Must be unique among all entity IDs ever provided on behalf
-	// of the merchant. You can never reuse an ID. This value can include alphanumeric
-	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
-	ID string `json:"id"`
-	// The modifier option's name.
-	Name string `json:"name"`
-	// The modifier option's price.
-	PriceMoney Money `json:"price_money"`
-	// If true, the modifier option is the default option in a modifier list for which
-	// selection_type is SINGLE.Default value: false
-	OnByDefault bool `json:"on_by_default"`
-}
-
 // Creates an item modifier option and adds it to a modifier list.
 //
 // Required permissions:  ITEMS_WRITE
-func CreateModifierOption(token, locationID, modifierListID string, reqObj *CreateModifierOptionReqObject) (*ModifierOption, error) {
+func (c *Client) CreateModifierOption(token, locationID, modifierListID string, reqObj *CreateModifierOptionReqObject) (*ModifierOption, error) {
+	return c.CreateModifierOptionContext(context.Background(), token, locationID, modifierListID, reqObj)
+}
+
+// CreateModifierOptionContext is like CreateModifierOption but uses ctx for the request.
+func (c *Client) CreateModifierOptionContext(ctx context.Context, token, locationID, modifierListID string, reqObj *CreateModifierOptionReqObject) (*ModifierOption, error) {
 	v := new(ModifierOption)
-	_, err := squareRequest("POST", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options", locationID, modifierListID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreateModifierOption", "POST", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options", locationID, modifierListID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateModifierOptionReqObject struct {
-	// The modifier option's name.
-	Name string `json:"name"`
-	// The modifier option's price.
-	PriceMoney Money `json:"price_money"`
-	// If true, the modifier option is the default option in a modifier list for which
-	// selection_type is SINGLE.
-	OnByDefault bool `json:"on_by_default"`
-}
-
 // Modifies the details of an existing item modifier option.
 //
 // Required permissions:  ITEMS_WRITE
-func UpdateModifierOption(token, locationID, modifierListID, modifierOptionID string, reqObj *UpdateModifierOptionReqObject) (*ModifierOption, error) {
+func (c *Client) UpdateModifierOption(token, locationID, modifierListID, modifierOptionID string, reqObj *UpdateModifierOptionReqObject) (*ModifierOption, error) {
+	return c.UpdateModifierOptionContext(context.Background(), token, locationID, modifierListID, modifierOptionID, reqObj)
+}
+
+// UpdateModifierOptionContext is like UpdateModifierOption but uses ctx for the request.
+func (c *Client) UpdateModifierOptionContext(ctx context.Context, token, locationID, modifierListID, modifierOptionID string, reqObj *UpdateModifierOptionReqObject) (*ModifierOption, error) {
 	v := new(ModifierOption)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options/%s", locationID, modifierListID, modifierOptionID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateModifierOption", "PUT", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options/%s", locationID, modifierListID, modifierOptionID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1140,33 +1219,33 @@ func UpdateModifierOption(token, locationID, modifierListID, modifierOptionID st
 // attempt to delete a modifier list's only option.
 //
 // Required permissions:  ITEMS_WRITE
-func DeleteModifierOption(token, locationID, modifierListID, modifierOptionID string) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options/%s", locationID, modifierListID, modifierOptionID), token, nil, nil)
+func (c *Client) DeleteModifierOption(token, locationID, modifierListID, modifierOptionID string) error {
+	return c.DeleteModifierOptionContext(context.Background(), token, locationID, modifierListID, modifierOptionID)
+}
+
+// DeleteModifierOptionContext is like DeleteModifierOption but uses ctx for the request.
+func (c *Client) DeleteModifierOptionContext(ctx context.Context, token, locationID, modifierListID, modifierOptionID string) error {
+	_, err := c.squareRequest(ctx, "DeleteModifierOption", "DELETE", fmt.Sprintf("/v1/%s/modifier-lists/%s/modifier-options/%s", locationID, modifierListID, modifierOptionID), token, nil, nil)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreateCategoryReqObject struct {
-	// The category's ID. Must be unique among all entity IDs ever provided on behalf of the
-	// merchant. You can never reuse an ID. This value can include alphanumeric
-	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
-	ID string `json:"id"`
-	// The category's name.
-	Name string `json:"name"`
-}
-
 // Creates an item category.
 //
 // To add or remove an item from a category, use the Update
 // Item endpoint.
 //
 // Required permissions:  ITEMS_WRITE
-func CreateCategory(token, locationID string, reqObj *CreateCategoryReqObject) (*Category, error) {
+func (c *Client) CreateCategory(token, locationID string, reqObj *CreateCategoryReqObject) (*Category, error) {
+	return c.CreateCategoryContext(context.Background(), token, locationID, reqObj)
+}
+
+// CreateCategoryContext is like CreateCategory but uses ctx for the request.
+func (c *Client) CreateCategoryContext(ctx context.Context, token, locationID string, reqObj *CreateCategoryReqObject) (*Category, error) {
 	v := new(Category)
-	_, err := squareRequest("POST", fmt.Sprintf("/v1/%s/categories", locationID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreateCategory", "POST", fmt.Sprintf("/v1/%s/categories", locationID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1176,30 +1255,34 @@ func CreateCategory(token, locationID string, reqObj *CreateCategoryReqObject) (
 // Lists all of a location's item categories.
 //
 // Required permissions:  ITEMS_READ
-func ListCategories(token, locationID string) ([]*Category, *NextRequest, error) {
+func (c *Client) ListCategories(token, locationID string) ([]*Category, *NextRequest, error) {
+	return c.ListCategoriesContext(context.Background(), token, locationID)
+}
+
+// ListCategoriesContext is like ListCategories but uses ctx for the request.
+func (c *Client) ListCategoriesContext(ctx context.Context, token, locationID string) ([]*Category, *NextRequest, error) {
 	v := make([]*Category, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/categories", locationID), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListCategories", "GET", fmt.Sprintf("/v1/%s/categories", locationID), token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
 	return v, nr, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateCategoryReqObject struct {
-	// The new name of the category.
-	Name string `json:"name"`
-}
-
 // Modifies the details of an existing item category.
 //
 // To add or remove an item from a category, use the Update
 // Item endpoint.
 //
 // Required permissions:  ITEMS_WRITE
-func UpdateCategory(token, locationID, categoryID string, reqObj *UpdateCategoryReqObject) (*Category, error) {
+func (c *Client) UpdateCategory(token, locationID, categoryID string, reqObj *UpdateCategoryReqObject) (*Category, error) {
+	return c.UpdateCategoryContext(context.Background(), token, locationID, categoryID, reqObj)
+}
+
+// UpdateCategoryContext is like UpdateCategory but uses ctx for the request.
+func (c *Client) UpdateCategoryContext(ctx context.Context, token, locationID, categoryID string, reqObj *UpdateCategoryReqObject) (*Category, error) {
 	v := new(Category)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/categories/%s", locationID, categoryID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateCategory", "PUT", fmt.Sprintf("/v1/%s/categories/%s", locationID, categoryID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1209,45 +1292,30 @@ func UpdateCategory(token, locationID, categoryID string, reqObj *UpdateCategory
 // Deletes an existing item category.
 //
 // Required permissions:  ITEMS_WRITE
-func DeleteCategory(token, locationID, categoryID string) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/%s/categories/%s", locationID, categoryID), token, nil, nil)
+func (c *Client) DeleteCategory(token, locationID, categoryID string) error {
+	return c.DeleteCategoryContext(context.Background(), token, locationID, categoryID)
+}
+
+// DeleteCategoryContext is like DeleteCategory but uses ctx for the request.
+func (c *Client) DeleteCategoryContext(ctx context.Context, token, locationID, categoryID string) error {
+	_, err := c.squareRequest(ctx, "DeleteCategory", "DELETE", fmt.Sprintf("/v1/%s/categories/%s", locationID, categoryID), token, nil, nil)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreateDiscountReqObject struct {
-	// The discount's ID. Must be unique among all entity IDs ever provided on behalf of the
-	// merchant. You can never reuse an ID. This value can include alphanumeric
-	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
-	ID string `json:"id"`
-	// The discount's name.
-	Name string `json:"name"`
-	// The rate of the discount, as a string representation of a decimal number. A value of
-	// 0.07 corresponds to a rate of 7%. Specify a rate of 0 if discount_type
-	// is VARIABLE_PERCENTAGE.Do not include this field for amount-based discounts.
-	Rate string `json:"rate"`
-	// The amount of the discount. Specify an amount of 0 if discount_type is
-	// VARIABLE_AMOUNT.Do not include this field for rate-based discounts.
-	AmountMoney Money `json:"amount_money"`
-	// Indicates whether the discount is a FIXED value or entered at the time of
-	// sale.Default value: FIXED
-	DiscountType string `json:"discount_type"`
-	// Indicates whether a mobile staff member needs to enter their PIN to apply the discount
-	// to a payment.Default value: false
-	PinRequired bool `json:"pin_required"`
-	// The color of the discount's display label in Square Register.Default value: 9da2a6
-	Color string `json:"color"`
-}
-
 // Creates a discount.
 //
 // Required permissions:  ITEMS_WRITE
-func CreateDiscount(token, locationID string, reqObj *CreateDiscountReqObject) (*Discount, error) {
+func (c *Client) CreateDiscount(token, locationID string, reqObj *CreateDiscountReqObject) (*Discount, error) {
+	return c.CreateDiscountContext(context.Background(), token, locationID, reqObj)
+}
+
+// CreateDiscountContext is like CreateDiscount but uses ctx for the request.
+func (c *Client) CreateDiscountContext(ctx context.Context, token, locationID string, reqObj *CreateDiscountReqObject) (*Discount, error) {
 	v := new(Discount)
-	_, err := squareRequest("POST", fmt.Sprintf("/v1/%s/discounts", locationID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreateDiscount", "POST", fmt.Sprintf("/v1/%s/discounts", locationID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1257,42 +1325,31 @@ func CreateDiscount(token, locationID string, reqObj *CreateDiscountReqObject) (
 // Lists all of a location's discounts.
 //
 // Required permissions:  ITEMS_READ
-func ListDiscounts(token, locationID string) ([]*Discount, *NextRequest, error) {
+func (c *Client) ListDiscounts(token, locationID string) ([]*Discount, *NextRequest, error) {
+	return c.ListDiscountsContext(context.Background(), token, locationID)
+}
+
+// ListDiscountsContext is like ListDiscounts but uses ctx for the request.
+func (c *Client) ListDiscountsContext(ctx context.Context, token, locationID string) ([]*Discount, *NextRequest, error) {
 	v := make([]*Discount, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/discounts", locationID), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListDiscounts", "GET", fmt.Sprintf("/v1/%s/discounts", locationID), token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
 	return v, nr, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateDiscountReqObject struct {
-	// The discount's name.
-	Name string `json:"name"`
-	// The rate of the discount, as a string representation of a decimal number. A value of
-	// 0.07 corresponds to a rate of 7%. Specify a rate of 0 if discount_type
-	// is VARIABLE_PERCENTAGE.Do not include this field for amount-based discounts.
-	Rate string `json:"rate"`
-	// The amount of the discount. Specify an amount of 0 if discount_type is
-	// VARIABLE_AMOUNT.Do not include this field for rate-based discounts.
-	AmountMoney Money `json:"amount_money"`
-	// Indicates whether the discount is a FIXED value or entered at the time of
-	// sale.Default value: FIXED
-	DiscountType string `json:"discount_type"`
-	// Indicates whether a mobile staff member needs to enter their PIN to apply the discount
-	// to a payment.Default value: false
-	PinRequired bool `json:"pin_required"`
-	// The color of the discount's display label in Square Register.Default value: 9da2a6
-	Color string `json:"color"`
-}
-
 // Modifies the details of an existing discount.
 //
 // Required permissions:  ITEMS_WRITE
-func UpdateDiscount(token, locationID, discountID string, reqObj *UpdateDiscountReqObject) (*Discount, error) {
+func (c *Client) UpdateDiscount(token, locationID, discountID string, reqObj *UpdateDiscountReqObject) (*Discount, error) {
+	return c.UpdateDiscountContext(context.Background(), token, locationID, discountID, reqObj)
+}
+
+// UpdateDiscountContext is like UpdateDiscount but uses ctx for the request.
+func (c *Client) UpdateDiscountContext(ctx context.Context, token, locationID, discountID string, reqObj *UpdateDiscountReqObject) (*Discount, error) {
 	v := new(Discount)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/discounts/%s", locationID, discountID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateDiscount", "PUT", fmt.Sprintf("/v1/%s/discounts/%s", locationID, discountID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1302,45 +1359,30 @@ func UpdateDiscount(token, locationID, discountID string, reqObj *UpdateDiscount
 // Deletes an existing discount.
 //
 // Required permissions:  ITEMS_WRITE
-func DeleteDiscount(token, locationID, discountID string) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/%s/discounts/%s", locationID, discountID), token, nil, nil)
+func (c *Client) DeleteDiscount(token, locationID, discountID string) error {
+	return c.DeleteDiscountContext(context.Background(), token, locationID, discountID)
+}
+
+// DeleteDiscountContext is like DeleteDiscount but uses ctx for the request.
+func (c *Client) DeleteDiscountContext(ctx context.Context, token, locationID, discountID string) error {
+	_, err := c.squareRequest(ctx, "DeleteDiscount", "DELETE", fmt.Sprintf("/v1/%s/discounts/%s", locationID, discountID), token, nil, nil)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreateFeeReqObject struct {
-	// The fee's ID. Must be unique among all entity IDs ever provided on behalf of the
-	// merchant. You can never reuse an ID. This value can include alphanumeric
-	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
-	ID string `json:"id"`
-	// The fee's name.
-	Name string `json:"name"`
-	// The rate of the fee, as a string representation of a decimal number. A value of
-	// 0.07 corresponds to a rate of 7%.
-	Rate string `json:"rate"`
-	// Whether the fee is calculated based on a payment's subtotal or total.Default value: FEE_SUBTOTAL_PHASE
-	CalculationPhase string `json:"calculation_phase"`
-	// The type of adjustment the fee applies to a payment. Currently, this value is
-	// TAX for all fees.Default value: TAX
-	AdjustmentType string `json:"adjustment_type"`
-	// If true, the fee applies to custom amounts entered into Square Register that are
-	// not associated with a particular item.Default value: true
-	AppliesToCustomAmounts bool `json:"applies_to_custom_amounts"`
-	// If true, the fee is applied to payments. If false, it isn't.Default value: true
-	Enabled bool `json:"enabled"`
-	// Whether the fee is ADDITIVE or INCLUSIVE.Default value: ADDITIVE
-	InclusionType string `json:"inclusion_type"`
-}
-
 // Creates a fee (tax).
 //
 // Required permissions:  ITEMS_WRITE
-func CreateFee(token, locationID string, reqObj *CreateFeeReqObject) (*Fee, error) {
+func (c *Client) CreateFee(token, locationID string, reqObj *CreateFeeReqObject) (*Fee, error) {
+	return c.CreateFeeContext(context.Background(), token, locationID, reqObj)
+}
+
+// CreateFeeContext is like CreateFee but uses ctx for the request.
+func (c *Client) CreateFeeContext(ctx context.Context, token, locationID string, reqObj *CreateFeeReqObject) (*Fee, error) {
 	v := new(Fee)
-	_, err := squareRequest("POST", fmt.Sprintf("/v1/%s/fees", locationID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreateFee", "POST", fmt.Sprintf("/v1/%s/fees", locationID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1350,43 +1392,31 @@ func CreateFee(token, locationID string, reqObj *CreateFeeReqObject) (*Fee, erro
 // Lists all of a location's fees (taxes).
 //
 // Required permissions:  ITEMS_READ
-func ListFees(token, locationID string) ([]*Fee, *NextRequest, error) {
+func (c *Client) ListFees(token, locationID string) ([]*Fee, *NextRequest, error) {
+	return c.ListFeesContext(context.Background(), token, locationID)
+}
+
+// ListFeesContext is like ListFees but uses ctx for the request.
+func (c *Client) ListFeesContext(ctx context.Context, token, locationID string) ([]*Fee, *NextRequest, error) {
 	v := make([]*Fee, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/fees", locationID), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListFees", "GET", fmt.Sprintf("/v1/%s/fees", locationID), token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
 	return v, nr, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateFeeReqObject struct {
-	// The fee's name.
-	Name string `json:"name"`
-	// The rate of the fee, as a string representation of a decimal number. A value of
-	// 0.07 corresponds to a rate of 7%.
-	Rate string `json:"rate"`
-	// Whether the fee is calculated based on a payment's subtotal or total.Default value: FEE_SUBTOTAL_PHASE
-	CalculationPhase string `json:"calculation_phase"`
-	// The type of adjustment the fee applies to a payment. Currently, this value is
-	// TAX for all fees.Default value: TAX
-	AdjustmentType string `json:"adjustment_type"`
-	// If true, the fee applies to custom amounts entered into Square Register that are
-	// not associated with a particular item.Default value: true
-	AppliesToCustomAmounts bool `json:"applies_to_custom_amounts"`
-	// If true, the fee is applied to all appropriate items. If false, the fee
-	// is not applied at all.Default value: true
-	Enabled bool `json:"enabled"`
-	// Whether the fee is ADDITIVE or INCLUSIVE.Default value: ADDITIVE
-	InclusionType string `json:"inclusion_type"`
-}
-
 // Modifies the details of an existing fee (tax).
 //
 // Required permissions:  ITEMS_WRITE
-func UpdateFee(token, locationID, feeID string, reqObj *UpdateFeeReqObject) (*Fee, error) {
+func (c *Client) UpdateFee(token, locationID, feeID string, reqObj *UpdateFeeReqObject) (*Fee, error) {
+	return c.UpdateFeeContext(context.Background(), token, locationID, feeID, reqObj)
+}
+
+// UpdateFeeContext is like UpdateFee but uses ctx for the request.
+func (c *Client) UpdateFeeContext(ctx context.Context, token, locationID, feeID string, reqObj *UpdateFeeReqObject) (*Fee, error) {
 	v := new(Fee)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/fees/%s", locationID, feeID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateFee", "PUT", fmt.Sprintf("/v1/%s/fees/%s", locationID, feeID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1396,8 +1426,13 @@ func UpdateFee(token, locationID, feeID string, reqObj *UpdateFeeReqObject) (*Fe
 // Deletes an existing fee (tax).
 //
 // Required permissions:  ITEMS_WRITE
-func DeleteFee(token, locationID, feeID string) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/%s/fees/%s", locationID, feeID), token, nil, nil)
+func (c *Client) DeleteFee(token, locationID, feeID string) error {
+	return c.DeleteFeeContext(context.Background(), token, locationID, feeID)
+}
+
+// DeleteFeeContext is like DeleteFee but uses ctx for the request.
+func (c *Client) DeleteFeeContext(ctx context.Context, token, locationID, feeID string) error {
+	_, err := c.squareRequest(ctx, "DeleteFee", "DELETE", fmt.Sprintf("/v1/%s/fees/%s", locationID, feeID), token, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -1408,9 +1443,14 @@ func DeleteFee(token, locationID, feeID string) error {
 // Register.
 //
 // Required permissions:  ITEMS_WRITE
-func ApplyFee(token, locationID, itemID, feeID string) (*Item, error) {
+func (c *Client) ApplyFee(token, locationID, itemID, feeID string) (*Item, error) {
+	return c.ApplyFeeContext(context.Background(), token, locationID, itemID, feeID)
+}
+
+// ApplyFeeContext is like ApplyFee but uses ctx for the request.
+func (c *Client) ApplyFeeContext(ctx context.Context, token, locationID, itemID, feeID string) (*Item, error) {
 	v := new(Item)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/items/%s/fees/%s", locationID, itemID, feeID), token, nil, v)
+	_, err := c.squareRequest(ctx, "ApplyFee", "PUT", fmt.Sprintf("/v1/%s/items/%s/fees/%s", locationID, itemID, feeID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1421,28 +1461,19 @@ func ApplyFee(token, locationID, itemID, feeID string) (*Item, error) {
 // the item in Square Register.
 //
 // Required permissions:  ITEMS_WRITE
-func RemoveFee(token, locationID, itemID, feeID string) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/%s/items/%s/fees/%s", locationID, itemID, feeID), token, nil, nil)
+func (c *Client) RemoveFee(token, locationID, itemID, feeID string) error {
+	return c.RemoveFeeContext(context.Background(), token, locationID, itemID, feeID)
+}
+
+// RemoveFeeContext is like RemoveFee but uses ctx for the request.
+func (c *Client) RemoveFeeContext(ctx context.Context, token, locationID, itemID, feeID string) error {
+	_, err := c.squareRequest(ctx, "RemoveFee", "DELETE", fmt.Sprintf("/v1/%s/items/%s/fees/%s", locationID, itemID, feeID), token, nil, nil)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type CreatePageReqObject struct {
-	// The page's ID. Must be unique among all entity IDs ever provided on behalf of the
-	// merchant. You can never reuse an ID. This value can include alphanumeric
-	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
-	ID string `json:"id"`
-	// The page's name.
-	Name string `json:"name"`
-	// The page's position in the list of pages. Must be an integer between 0 and
-	// 4, inclusive.The endpoint returns an error if you specify a page_index that another page is
-	// already using.
-	PageIndex int `json:"page_index"`
-}
-
 // Creates a Favorites page in Square Register.
 //
 // A merchant can have up to five pages, each of which has a page_index between 0
@@ -1452,9 +1483,14 @@ type CreatePageReqObject struct {
 // unless at least one of its cells has an assigned value.
 //
 // Required permissions:  ITEMS_WRITE
-func CreatePage(token, locationID string, reqObj *CreatePageReqObject) (*Page, error) {
+func (c *Client) CreatePage(token, locationID string, reqObj *CreatePageReqObject) (*Page, error) {
+	return c.CreatePageContext(context.Background(), token, locationID, reqObj)
+}
+
+// CreatePageContext is like CreatePage but uses ctx for the request.
+func (c *Client) CreatePageContext(ctx context.Context, token, locationID string, reqObj *CreatePageReqObject) (*Page, error) {
 	v := new(Page)
-	_, err := squareRequest("POST", fmt.Sprintf("/v1/%s/pages", locationID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "CreatePage", "POST", fmt.Sprintf("/v1/%s/pages", locationID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1464,31 +1500,31 @@ func CreatePage(token, locationID string, reqObj *CreatePageReqObject) (*Page, e
 // Lists all of a location's Favorites pages in Square Register.
 //
 // Required permissions:  ITEMS_READ
-func ListPages(token, locationID string) ([]*Page, *NextRequest, error) {
+func (c *Client) ListPages(token, locationID string) ([]*Page, *NextRequest, error) {
+	return c.ListPagesContext(context.Background(), token, locationID)
+}
+
+// ListPagesContext is like ListPages but uses ctx for the request.
+func (c *Client) ListPagesContext(ctx context.Context, token, locationID string) ([]*Page, *NextRequest, error) {
 	v := make([]*Page, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/pages", locationID), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListPages", "GET", fmt.Sprintf("/v1/%s/pages", locationID), token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
 	return v, nr, nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdatePageReqObject struct {
-	// The page's name.
-	Name string `json:"name"`
-	// The page's position in the merchant's list of pages. Must be an integer between
-	// 0 and 4, inclusive.The page's index is not updated if another page already exists at the specified
-	// index.
-	PageIndex int `json:"page_index"`
-}
-
 // Modifies the details of a Favorites page in Square Register.
 //
 // Required permissions:  ITEMS_WRITE
-func UpdatePage(token, locationID, pageID string, reqObj *UpdatePageReqObject) (*Page, error) {
+func (c *Client) UpdatePage(token, locationID, pageID string, reqObj *UpdatePageReqObject) (*Page, error) {
+	return c.UpdatePageContext(context.Background(), token, locationID, pageID, reqObj)
+}
+
+// UpdatePageContext is like UpdatePage but uses ctx for the request.
+func (c *Client) UpdatePageContext(ctx context.Context, token, locationID, pageID string, reqObj *UpdatePageReqObject) (*Page, error) {
 	v := new(Page)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/pages/%s", locationID, pageID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdatePage", "PUT", fmt.Sprintf("/v1/%s/pages/%s", locationID, pageID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1498,39 +1534,30 @@ func UpdatePage(token, locationID, pageID string, reqObj *UpdatePageReqObject) (
 // Deletes an existing Favorites page and all of its cells.
 //
 // Required permissions:  ITEMS_WRITE
-func DeletePage(token, locationID, pageID string) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/%s/pages/%s", locationID, pageID), token, nil, nil)
+func (c *Client) DeletePage(token, locationID, pageID string) error {
+	return c.DeletePageContext(context.Background(), token, locationID, pageID)
+}
+
+// DeletePageContext is like DeletePage but uses ctx for the request.
+func (c *Client) DeletePageContext(ctx context.Context, token, locationID, pageID string) error {
+	_, err := c.squareRequest(ctx, "DeletePage", "DELETE", fmt.Sprintf("/v1/%s/pages/%s", locationID, pageID), token, nil, nil)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type UpdateCellReqObject struct {
-	// The row of the cell to update. Always an integer between 0 and 4,
-	// inclusive. Row 0 is the top row.
-	Row int `json:"row"`
-	// The column of the cell to update. Always an integer between 0 and 4,
-	// inclusive. Column 0 is the leftmost row.
-	Column int `json:"column"`
-	// The type of entity represented in the cell (ITEM, DISCOUNT,
-	// CATEGORY, or PLACEHOLDER).
-	ObjectType string `json:"object_type"`
-	// The unique identifier of the entity to represent in the cell. Do not include if the
-	// cell's object_type is PLACEHOLDER.
-	ObjectID string `json:"object_id"`
-	// For a cell with an object_type of PLACEHOLDER, indicates the cell's
-	// behavior.
-	PlaceholderType string `json:"placeholder_type"`
-}
-
 // Modifies a cell of a Favorites page in Square Register.
 //
 // Required permissions:  ITEMS_WRITE
-func UpdateCell(token, locationID, pageID string, reqObj *UpdateCellReqObject) (*PageCell, error) {
+func (c *Client) UpdateCell(token, locationID, pageID string, reqObj *UpdateCellReqObject) (*PageCell, error) {
+	return c.UpdateCellContext(context.Background(), token, locationID, pageID, reqObj)
+}
+
+// UpdateCellContext is like UpdateCell but uses ctx for the request.
+func (c *Client) UpdateCellContext(ctx context.Context, token, locationID, pageID string, reqObj *UpdateCellReqObject) (*PageCell, error) {
 	v := new(PageCell)
-	_, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/pages/%s/cells", locationID, pageID), token, reqObj, v)
+	_, err := c.squareRequest(ctx, "UpdateCell", "PUT", fmt.Sprintf("/v1/%s/pages/%s/cells", locationID, pageID), token, reqObj, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1548,90 +1575,71 @@ func UpdateCell(token, locationID, pageID string, reqObj *UpdateCellReqObject) (
 // `column`:
 // The column of the cell to clear. Always an integer between 0 and 4,
 // inclusive. Column 0 is the leftmost column.
-func DeleteCell(token, locationID, pageID string, row, column int) error {
-	_, err := squareRequest("DELETE", fmt.Sprintf("/v1/%s/pages/%s/cells?row=%d&column=%d", locationID, pageID, row, column), token, nil, nil)
+func (c *Client) DeleteCell(token, locationID, pageID string, row, column int) error {
+	return c.DeleteCellContext(context.Background(), token, locationID, pageID, row, column)
+}
+
+// DeleteCellContext is like DeleteCell but uses ctx for the request.
+func (c *Client) DeleteCellContext(ctx context.Context, token, locationID, pageID string, row, column int) error {
+	_, err := c.squareRequest(ctx, "DeleteCell", "DELETE", deleteCellPath(locationID, pageID, row, column), token, nil, nil)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
-type SubmitBatchReqObject struct {
-	// The requests to perform.
-	Requests []*BatchRequest `json:"requests"`
+// Lists which types of events trigger webhook notifications for a particular location.
+func (c *Client) ListWebhooks(token, locationID string) ([]string, *NextRequest, error) {
+	return c.ListWebhooksContext(context.Background(), token, locationID)
 }
 
-// Lets you batch multiple requests to other Connect API endpoints into a single request. This
-// endpoint's response is an array that contains the response for each batched request.
-//
-// You don't need to provide an access token in the header of your request to the Submit Batch
-// endpoint. Instead, you provide an access_token parameter for each request included in
-// the batch.
-//
-// Note the following when using the Submit Batch endpoint:
-func SubmitBatch(token string, batchRequests []*BatchRequest) ([]*BatchResponse, error) {
-	if len(batchRequests) > 30 {
-		return nil, fmt.Errorf("You cannot submit more than 30 requests to `/v1/batch`")
-	}
-	reqObj := new(SubmitBatchReqObject)
-	reqObj.Requests = batchRequests
-	v := make([]*BatchResponse, 0)
-	_, err := squareRequest("POST", "/v1/batch", token, reqObj, &v)
+// ListWebhooksContext is like ListWebhooks but uses ctx for the request.
+func (c *Client) ListWebhooksContext(ctx context.Context, token, locationID string) ([]string, *NextRequest, error) {
+	v := make([]string, 0)
+	nr, err := c.squareRequest(ctx, "ListWebhooks", "GET", fmt.Sprintf("/v1/%s/webhooks", locationID), token, nil, &v)
 	if err != nil {
-		return nil, err
-	}
-	reqMap := make(map[string]*BatchRequest)
-	for _, br := range reqObj.Requests {
-		reqMap[br.RequestID] = br
-	}
-	for _, bResp := range v {
-		bReq := reqMap[bResp.RequestID]
-		if bReq.Method != "DELETE" {
-			headers, ok := bResp.Headers.(map[string]string)
-			if ok {
-				if link, ok := headers["Link"]; ok && len(link) > 0 {
-					bResp.NextRequest = newNextRequest(link, bReq.AccessToken)
-				}
-			}
-			if bReq.result != nil {
-				buf := new(bytes.Buffer)
-				enc := json.NewEncoder(buf)
-				if err := enc.Encode(bResp.Body); err != nil {
-					return nil, err
-				}
-				dec := json.NewDecoder(buf)
-				if err := dec.Decode(bReq.result); err != nil {
-					return nil, err
-				}
-				bResp.Body = bReq.result
-			}
-		}
+		return nil, nil, err
 	}
-	return v, nil
+	return v, nr, nil
 }
 
-// Lists which types of events trigger webhook notifications for a particular location.
-func ListWebhooks(token, locationID string) ([]string, *NextRequest, error) {
+// Sets which types of events trigger webhook notifications for a location.
+//
+// It sends no event types, and its NextRequest is always nil.
+//
+// Deprecated: use UpdateWebhooksWithEventTypes instead.
+func (c *Client) UpdateWebhooks(token, locationID string) ([]string, *NextRequest, error) {
+	return c.UpdateWebhooksContext(context.Background(), token, locationID)
+}
+
+// UpdateWebhooksContext is like UpdateWebhooks but uses ctx for the request.
+//
+// Deprecated: use UpdateWebhooksWithEventTypesContext instead.
+func (c *Client) UpdateWebhooksContext(ctx context.Context, token, locationID string) ([]string, *NextRequest, error) {
 	v := make([]string, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/v1/%s/webhooks", locationID), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "UpdateWebhooks", "PUT", fmt.Sprintf("/v1/%s/webhooks", locationID), token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
 	return v, nr, nil
 }
 
-// Sets which types of events trigger webhook notifications for a location.
+// Sets which types of events trigger webhook notifications for a location, and returns
+// them.
 //
-// Simply provide a JSON array of the event types you want notifications for in your request
-// body (see Example Requests below).
-func UpdateWebhooks(token, locationID string) ([]string, *NextRequest, error) {
+// Simply provide the event types you want notifications for as eventTypes.
+func (c *Client) UpdateWebhooksWithEventTypes(token, locationID string, eventTypes []string) ([]string, error) {
+	return c.UpdateWebhooksWithEventTypesContext(context.Background(), token, locationID, eventTypes)
+}
+
+// UpdateWebhooksWithEventTypesContext is like UpdateWebhooksWithEventTypes but uses ctx for the request.
+func (c *Client) UpdateWebhooksWithEventTypesContext(ctx context.Context, token, locationID string, eventTypes []string) ([]string, error) {
 	v := make([]string, 0)
-	nr, err := squareRequest("PUT", fmt.Sprintf("/v1/%s/webhooks", locationID), token, nil, &v)
+	_, err := c.squareRequest(ctx, "UpdateWebhooks", "PUT", fmt.Sprintf("/v1/%s/webhooks", locationID), token, eventTypes, &v)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	return v, nr, nil
+	return v, nil
 }
 
 // Lists subscriptions that have been created for an application. You can look up subscription
@@ -1654,9 +1662,33 @@ func UpdateWebhooks(token, locationID string) ([]string, *NextRequest, error) {
 // `limit`:
 // The maximum number of subscriptions to return in a single response. This value cannot
 // exceed 200.Default value: 100
-func ListSubscriptions(token, clientID, merchantID string, limit int) ([]*Subscription, *NextRequest, error) {
+//
+// Deprecated: use ListSubscriptionsWithOptions, which takes typed filters.
+func (c *Client) ListSubscriptions(token, clientID, merchantID string, limit int) ([]*Subscription, *NextRequest, error) {
+	return c.ListSubscriptionsContext(context.Background(), token, clientID, merchantID, limit)
+}
+
+// ListSubscriptionsContext is like ListSubscriptions but uses ctx for the request.
+//
+// Deprecated: use ListSubscriptionsWithOptionsContext, which takes typed filters.
+func (c *Client) ListSubscriptionsContext(ctx context.Context, token, clientID, merchantID string, limit int) ([]*Subscription, *NextRequest, error) {
+	return c.listSubscriptions(ctx, token, listSubscriptionsPath(clientID, merchantID, limit))
+}
+
+// ListSubscriptionsWithOptions is like ListSubscriptions but takes its filters as
+// a ListSubscriptionsOptions, nil for none.
+func (c *Client) ListSubscriptionsWithOptions(token, clientID string, opts *ListSubscriptionsOptions) ([]*Subscription, *NextRequest, error) {
+	return c.ListSubscriptionsWithOptionsContext(context.Background(), token, clientID, opts)
+}
+
+// ListSubscriptionsWithOptionsContext is like ListSubscriptionsWithOptions but uses ctx for the request.
+func (c *Client) ListSubscriptionsWithOptionsContext(ctx context.Context, token, clientID string, opts *ListSubscriptionsOptions) ([]*Subscription, *NextRequest, error) {
+	return c.listSubscriptions(ctx, token, opts.path(clientID))
+}
+
+func (c *Client) listSubscriptions(ctx context.Context, token, path string) ([]*Subscription, *NextRequest, error) {
 	v := make([]*Subscription, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/oauth2/clients/%s/subscriptions?merchant_id=%s&limit=%d", clientID, merchantID, limit), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListSubscriptions", "GET", path, token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1668,9 +1700,14 @@ func ListSubscriptions(token, clientID, merchantID string, limit int) ([]*Subscr
 //
 // Important: The Authorization header you provide to this endpoint must have the
 // following format:
-func RetrieveSubscription(token, clientID, subscriptionID string) (*Subscription, error) {
+func (c *Client) RetrieveSubscription(token, clientID, subscriptionID string) (*Subscription, error) {
+	return c.RetrieveSubscriptionContext(context.Background(), token, clientID, subscriptionID)
+}
+
+// RetrieveSubscriptionContext is like RetrieveSubscription but uses ctx for the request.
+func (c *Client) RetrieveSubscriptionContext(ctx context.Context, token, clientID, subscriptionID string) (*Subscription, error) {
 	v := new(Subscription)
-	_, err := squareRequest("GET", fmt.Sprintf("/oauth2/clients/%s/subscriptions/%s", clientID, subscriptionID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveSubscription", "GET", fmt.Sprintf("/oauth2/clients/%s/subscriptions/%s", clientID, subscriptionID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
@@ -1681,18 +1718,28 @@ func RetrieveSubscription(token, clientID, subscriptionID string) (*Subscription
 //
 // Important: The Authorization header you provide to this endpoint must have the
 // following format:
-func ListSubscriptionPlans(token, clientID string) ([]*SubscriptionPlan, *NextRequest, error) {
+func (c *Client) ListSubscriptionPlans(token, clientID string) ([]*SubscriptionPlan, *NextRequest, error) {
+	return c.ListSubscriptionPlansContext(context.Background(), token, clientID)
+}
+
+// ListSubscriptionPlansContext is like ListSubscriptionPlans but uses ctx for the request.
+func (c *Client) ListSubscriptionPlansContext(ctx context.Context, token, clientID string) ([]*SubscriptionPlan, *NextRequest, error) {
 	v := make([]*SubscriptionPlan, 0)
-	nr, err := squareRequest("GET", fmt.Sprintf("/oauth2/clients/%s/plans", clientID), token, nil, &v)
+	nr, err := c.squareRequest(ctx, "ListSubscriptionPlans", "GET", fmt.Sprintf("/oauth2/clients/%s/plans", clientID), token, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
 	return v, nr, nil
 }
 
-func RetrieveSubscriptionPlan(token, clientID, planID string) (*SubscriptionPlan, error) {
+func (c *Client) RetrieveSubscriptionPlan(token, clientID, planID string) (*SubscriptionPlan, error) {
+	return c.RetrieveSubscriptionPlanContext(context.Background(), token, clientID, planID)
+}
+
+// RetrieveSubscriptionPlanContext is like RetrieveSubscriptionPlan but uses ctx for the request.
+func (c *Client) RetrieveSubscriptionPlanContext(ctx context.Context, token, clientID, planID string) (*SubscriptionPlan, error) {
 	v := new(SubscriptionPlan)
-	_, err := squareRequest("GET", fmt.Sprintf("/oauth2/clients/%s/plans/%s", clientID, planID), token, nil, v)
+	_, err := c.squareRequest(ctx, "RetrieveSubscriptionPlan", "GET", fmt.Sprintf("/oauth2/clients/%s/plans/%s", clientID, planID), token, nil, v)
 	if err != nil {
 		return nil, err
 	}
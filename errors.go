@@ -0,0 +1,148 @@
+package gosquare
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// The largest error body read from a failed response.
+const _MaxErrorBody = 64 << 10
+
+// APIError is returned by every call that gets a non-2xx response from Square.
+// Use errors.As to get at it, or one of the Is* helpers below.
+type APIError struct {
+	// The response's HTTP status code.
+	StatusCode int `json:"-"`
+	// Square's error type, for example "not_found" or "bad_request".
+	Type string `json:"type"`
+	// Square's human readable description of the error.
+	Message string `json:"message"`
+	// The HTTP method of the failed request.
+	Method string `json:"-"`
+	// The path of the failed request, relative to the client's base url.
+	Path string `json:"-"`
+	// The response headers, if any. Not populated for batched requests
+	// beyond what Square includes in the batch response.
+	Header http.Header `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	var msg string
+	switch {
+	case len(e.Type) > 0 && len(e.Message) > 0:
+		msg = fmt.Sprintf("%s: %s", e.Type, e.Message)
+	case len(e.Message) > 0:
+		msg = e.Message
+	case len(e.Type) > 0:
+		msg = e.Type
+	default:
+		msg = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("gosquare: %s %s: %d %s", e.Method, e.Path, e.StatusCode, msg)
+}
+
+// newAPIError builds an APIError out of a failed response,
+// the body is decoded as Square's {"type", "message"} object if possible
+// and otherwise kept as the message.
+func newAPIError(method, path string, resp *http.Response) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		Path:       path,
+		Header:     resp.Header,
+	}
+	bts, _ := io.ReadAll(io.LimitReader(resp.Body, _MaxErrorBody))
+	e.decodeBody(bts)
+	return e
+}
+
+func (e *APIError) decodeBody(bts []byte) {
+	if err := json.Unmarshal(bts, e); err != nil {
+		e.Message = strings.TrimSpace(string(bts))
+	}
+}
+
+// Err returns an *APIError if the batched request failed. If it succeeded but
+// its response couldn't be read, because its Link header is invalid or its body
+// doesn't decode, Err returns that error, nil otherwise.
+// The Body of a failed response is left as Square returned it.
+func (br *BatchResponse) Err() error {
+	if isSuccess(br.StatusCode) {
+		return br.err
+	}
+	e := &APIError{
+		StatusCode: br.StatusCode,
+		Header:     make(http.Header),
+	}
+	if br.request != nil {
+		e.Method = br.request.Method
+		e.Path = br.request.RelativePath
+	}
+	for k, v := range br.Headers {
+		e.Header.Set(k, v)
+	}
+	if len(br.body) > 0 {
+		e.decodeBody(br.body)
+	} else if bts, err := json.Marshal(br.Body); err == nil {
+		e.decodeBody(bts)
+	}
+	return e
+}
+
+func isSuccess(statusCode int) bool {
+	return statusCode >= 200 && statusCode < 300
+}
+
+// Returns the *APIError wrapped by err, if any.
+func asAPIError(err error) (*APIError, bool) {
+	var e *APIError
+	ok := errors.As(err, &e)
+	return e, ok
+}
+
+func hasStatus(err error, statusCode int) bool {
+	e, ok := asAPIError(err)
+	return ok && e.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err is an APIError with a 404 status.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an APIError with a 401 status,
+// usually an expired or revoked access token.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is an APIError with a 403 status,
+// usually a token lacking the endpoint's required permissions.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsRateLimited reports whether err is an APIError with a 429 status.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsRetryable reports whether err is an APIError that may succeed if the same
+// request is sent again: rate limiting and transient server errors.
+func IsRetryable(err error) bool {
+	e, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+	switch e.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests,
+		http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,9 @@
+package gosquare
+
+// The endpoint methods in endpoints.go, batch_endpoints.go, iter_endpoints.go
+// and default_endpoints.go are generated from the endpoint table in
+// internal/gen/table.go. Edit the table, then run go generate.
+// go run ./internal/gen -check, and the tests of internal/gen, report the
+// files that are out of date.
+
+//go:generate go run ./internal/gen
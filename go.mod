@@ -0,0 +1,3 @@
+module github.com/nathanjsweet/gosquare
+
+go 1.25.0
@@ -0,0 +1,199 @@
+package gosquaretest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// collection is the ordered set of objects listed by an endpoint,
+// keyed by the path of that endpoint.
+type collection struct {
+	// idKey is the field identifying the objects, "id" for most.
+	idKey string
+	ids   []string
+	objs  map[string]object
+}
+
+func newCollection(idKey string) *collection {
+	return &collection{idKey: idKey, objs: make(map[string]object)}
+}
+
+// coll returns the collection at path, creating it if need be.
+func (s *Server) coll(path string) *collection {
+	c, ok := s.colls[path]
+	if !ok {
+		idKey := "id"
+		if strings.HasSuffix(path, "/inventory") {
+			idKey = "variation_id"
+		}
+		c = newCollection(idKey)
+		s.colls[path] = c
+	}
+	return c
+}
+
+func (c *collection) get(id string) (object, bool) {
+	obj, ok := c.objs[id]
+	return obj, ok
+}
+
+// put adds obj, or replaces the object with the same id.
+func (c *collection) put(obj object) {
+	id := str(obj[c.idKey])
+	if _, ok := c.objs[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.objs[id] = obj
+}
+
+func (c *collection) remove(id string) (object, bool) {
+	obj, ok := c.objs[id]
+	if !ok {
+		return nil, false
+	}
+	delete(c.objs, id)
+	for i, v := range c.ids {
+		if v == id {
+			c.ids = append(c.ids[:i:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	return obj, true
+}
+
+func (c *collection) all() []object {
+	objs := make([]object, len(c.ids))
+	for i, id := range c.ids {
+		objs[i] = c.objs[id]
+	}
+	return objs
+}
+
+// listing describes how a list endpoint filters, sorts and pages its objects.
+type listing struct {
+	// sortBy is the timestamp field the order parameter sorts by,
+	// objects are listed in insertion order if empty.
+	sortBy string
+	// equal maps query parameters to the fields they must be equal to.
+	equal map[string]string
+	// ranges maps the begin_ parameters to the timestamp field they bound,
+	// the end_ parameter being implied.
+	ranges map[string]string
+	// paged is false for the endpoints that return all their objects at once.
+	paged bool
+}
+
+// list replies with the page of objs selected by the query of c, and a Link
+// header to the next page if there is one.
+func (s *Server) list(c *call, objs []object, l listing) *reply {
+	objs = filter(c, objs, l)
+	if len(l.sortBy) > 0 {
+		desc := strings.EqualFold(c.param("order"), "DESC")
+		sort.SliceStable(objs, func(i, j int) bool {
+			a, b := str(objs[i][l.sortBy]), str(objs[j][l.sortBy])
+			if desc {
+				return timeLess(b, a)
+			}
+			return timeLess(a, b)
+		})
+	}
+	if !l.paged {
+		return okReply(objs)
+	}
+	size := s.pageSize
+	if v := c.param("limit"); len(v) > 0 {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return errorReply(400, "", fmt.Sprintf("invalid limit %q", v))
+		}
+		if n > 0 {
+			size = n
+		}
+	}
+	offset := 0
+	if v := c.param("batch_token"); len(v) > 0 {
+		n, err := decodeBatchToken(v)
+		if err != nil || n > len(objs) {
+			return errorReply(400, "", "invalid batch_token")
+		}
+		offset = n
+	}
+	end := offset + size
+	if end >= len(objs) {
+		return okReply(objs[offset:])
+	}
+	rep := okReply(objs[offset:end])
+	q := make(url.Values, len(c.query)+1)
+	for k, v := range c.query {
+		q[k] = v
+	}
+	q.Set("batch_token", encodeBatchToken(end))
+	rep.header.Set("Link", fmt.Sprintf("<%s%s?%s>;rel='next'", s.URL, c.path, q.Encode()))
+	return rep
+}
+
+func filter(c *call, objs []object, l listing) []object {
+	out := make([]object, 0, len(objs))
+next:
+	for _, obj := range objs {
+		for param, field := range l.equal {
+			if v := c.param(param); len(v) > 0 && str(obj[field]) != v {
+				continue next
+			}
+		}
+		for param, field := range l.ranges {
+			v := str(obj[field])
+			if begin := c.param(param); len(begin) > 0 && timeLess(v, begin) {
+				continue next
+			}
+			endParam := "end_" + strings.TrimPrefix(param, "begin_")
+			if end := c.param(endParam); len(end) > 0 && !timeLess(v, end) {
+				continue next
+			}
+		}
+		out = append(out, obj)
+	}
+	return out
+}
+
+// timeLess compares two timestamps, as strings if they aren't RFC 3339.
+func timeLess(a, b string) bool {
+	ta, errA := time.Parse(time.RFC3339, a)
+	tb, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return ta.Before(tb)
+}
+
+func encodeBatchToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+func decodeBatchToken(token string) (int, error) {
+	bts, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	v, ok := strings.CutPrefix(string(bts), "offset:")
+	if !ok {
+		return 0, fmt.Errorf("invalid batch_token")
+	}
+	return strconv.Atoi(v)
+}
+
+// str returns the string form of a JSON value, "" for null.
+func str(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,108 @@
+package recorder_test
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+	"github.com/nathanjsweet/gosquare/gosquaretest/recorder"
+)
+
+func TestRecordReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	srv := gosquaretest.NewServer()
+	srv.SeedPayments("L", &gosquare.Payment{ID: "P1"}, &gosquare.Payment{ID: "P2"})
+
+	rec, err := recorder.New(path, recorder.ModeAuto, srv.Server.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rec.Recording() {
+		t.Fatal("a missing cassette isn't recorded")
+	}
+	c := gosquare.NewClient(srv.URL, "SECRET_ACCESS_TOKEN")
+	c.HTTPClient = rec.Client()
+	if _, err := c.RetrievePayment("", "L", "P1"); err != nil {
+		t.Fatal(err)
+	}
+	token, err := c.GetToken("SECRET_CODE", "APP_ID", "SECRET_APP_SECRET")
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := []*gosquare.BatchCall[*gosquare.Payment]{
+		c.RetrievePaymentBatchCall("", "L", "P1"),
+		c.RetrievePaymentBatchCall("", "L", "P2"),
+	}
+	if _, err := c.SubmitBatch("", []*gosquare.BatchRequest{calls[0].BatchRequest, calls[1].BatchRequest}); err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest("GET", srv.URL+"/v1/me", nil)
+	req.Header.Set("Authorization", "Bearer SECRET_ACCESS_TOKEN")
+	req.Header.Set("X-Square-Signature", "SECRET_SIGNATURE")
+	resp, err := rec.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"SECRET", token.AccessToken} {
+		if strings.Contains(string(bts), secret) {
+			t.Errorf("the cassette holds %q:\n%s", secret, bts)
+		}
+	}
+
+	rec, err = recorder.New(path, recorder.ModeAuto, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Recording() {
+		t.Fatal("an existing cassette is recorded again")
+	}
+	c = gosquare.NewClient(srv.URL, "ANOTHER_ACCESS_TOKEN")
+	c.HTTPClient = rec.Client()
+	p, err := c.RetrievePayment("", "L", "P1")
+	if err != nil || p.ID != "P1" {
+		t.Fatalf("replayed RetrievePayment = %v, %v, want P1", p, err)
+	}
+	if token, err := c.GetToken("ANOTHER_CODE", "APP_ID", "ANOTHER_APP_SECRET"); err != nil || token.AccessToken != recorder.Redacted {
+		t.Fatalf("replayed GetToken = %+v, %v, want a redacted token", token, err)
+	}
+	// the batch request ids are new, the replayed responses must carry them
+	calls = []*gosquare.BatchCall[*gosquare.Payment]{
+		c.RetrievePaymentBatchCall("", "L", "P1"),
+		c.RetrievePaymentBatchCall("", "L", "P2"),
+	}
+	resps, err := c.SubmitBatch("", []*gosquare.BatchRequest{calls[0].BatchRequest, calls[1].BatchRequest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, call := range calls {
+		if resps[i].RequestID != call.RequestID {
+			t.Errorf("response %d has request id %s, want %s", i, resps[i].RequestID, call.RequestID)
+		}
+		p, err := call.Result()
+		if err != nil || p.ID != []string{"P1", "P2"}[i] {
+			t.Errorf("replayed batch call %d = %v, %v", i, p, err)
+		}
+	}
+
+	if _, err := c.RetrievePayment("", "L", "P2"); !errors.Is(err, recorder.ErrNoMatch) {
+		t.Errorf("an unrecorded request got %v, want ErrNoMatch", err)
+	}
+	if _, err := c.RetrievePayment("", "L", "P1"); !errors.Is(err, recorder.ErrNoMatch) {
+		t.Errorf("a request replayed twice got %v, want ErrNoMatch", err)
+	}
+}
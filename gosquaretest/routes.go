@@ -0,0 +1,910 @@
+package gosquaretest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// handler serves a call, args are the path segments matched by
+// the wildcards of its route.
+type handler func(s *Server, c *call, args []string) *reply
+
+type route struct {
+	method string
+	// pattern is a path whose "*" segments match any segment.
+	pattern string
+	handle  handler
+}
+
+var routes []route
+
+func init() {
+	routes = []route{
+		{"GET", "/v1/me", retrieveMerchant},
+		{"GET", "/v1/me/locations", listAll(listing{paged: true})},
+
+		{"POST", "/v1/me/employees", create("EMPLOYEE", true, defaults{"status": "ACTIVE"})},
+		{"GET", "/v1/me/employees", listAll(listing{
+			sortBy: "created_at",
+			equal:  map[string]string{"status": "status", "external_id": "external_id"},
+			ranges: map[string]string{"begin_updated_at": "updated_at", "begin_created_at": "created_at"},
+			paged:  true,
+		})},
+		{"GET", "/v1/me/employees/*", retrieve},
+		{"PUT", "/v1/me/employees/*", update(true)},
+
+		{"POST", "/v1/me/roles", create("ROLE", true, nil)},
+		{"GET", "/v1/me/roles", listAll(listing{sortBy: "created_at", paged: true})},
+		{"GET", "/v1/me/roles/*", retrieve},
+		{"PUT", "/v1/me/roles/*", update(true)},
+
+		{"POST", "/v1/me/timecards", createTimecard},
+		{"GET", "/v1/me/timecards", listAll(listing{
+			sortBy: "clockin_time",
+			equal:  map[string]string{"employee_id": "employee_id", "deleted": "deleted"},
+			ranges: map[string]string{
+				"begin_clockin_time":  "clockin_time",
+				"begin_clockout_time": "clockout_time",
+				"begin_updated_at":    "updated_at",
+			},
+			paged: true,
+		})},
+		{"GET", "/v1/me/timecards/*", retrieve},
+		{"PUT", "/v1/me/timecards/*", updateTimecard},
+		{"DELETE", "/v1/me/timecards/*", deleteTimecard},
+		{"GET", "/v1/me/timecards/*/events", listAll(listing{sortBy: "created_at", paged: true})},
+
+		{"GET", "/v1/*/cash-drawer-shifts", listAll(listing{
+			sortBy: "opened_at",
+			ranges: map[string]string{"begin_time": "opened_at"},
+			paged:  true,
+		})},
+		{"GET", "/v1/*/cash-drawer-shifts/*", retrieve},
+
+		{"GET", "/v1/*/payments", listAll(listing{
+			sortBy: "created_at",
+			ranges: map[string]string{"begin_time": "created_at"},
+			paged:  true,
+		})},
+		{"GET", "/v1/*/payments/*", retrieve},
+
+		{"GET", "/v1/*/settlements", listAll(listing{
+			sortBy: "initiated_at",
+			equal:  map[string]string{"status": "status"},
+			ranges: map[string]string{"begin_time": "initiated_at"},
+			paged:  true,
+		})},
+		{"GET", "/v1/*/settlements/*", retrieve},
+
+		{"POST", "/v1/*/refunds", createRefund},
+		{"GET", "/v1/*/refunds", listAll(listing{
+			sortBy: "created_at",
+			ranges: map[string]string{"begin_time": "created_at"},
+			paged:  true,
+		})},
+
+		{"GET", "/v1/*/orders", listAll(listing{sortBy: "created_at", paged: true})},
+		{"GET", "/v1/*/orders/*", retrieve},
+		{"PUT", "/v1/*/orders/*", updateOrder},
+
+		{"GET", "/v1/*/bank-accounts", listAll(listing{paged: true})},
+		{"GET", "/v1/*/bank-accounts/*", retrieve},
+
+		{"POST", "/v1/*/items", createItem},
+		{"GET", "/v1/*/items", listAll(listing{paged: true})},
+		{"GET", "/v1/*/items/*", retrieve},
+		{"PUT", "/v1/*/items/*", updateItem},
+		{"DELETE", "/v1/*/items/*", remove},
+		{"POST", "/v1/*/items/*/image", uploadItemImage},
+		{"POST", "/v1/*/items/*/variations", createVariation},
+		{"PUT", "/v1/*/items/*/variations/*", updateVariation},
+		{"DELETE", "/v1/*/items/*/variations/*", deleteVariation},
+		{"PUT", "/v1/*/items/*/modifier-lists/*", applyToItem("modifier_lists", "modifier-lists")},
+		{"DELETE", "/v1/*/items/*/modifier-lists/*", removeFromItem("modifier_lists")},
+		{"PUT", "/v1/*/items/*/fees/*", applyToItem("fees", "fees")},
+		{"DELETE", "/v1/*/items/*/fees/*", removeFromItem("fees")},
+
+		{"GET", "/v1/*/inventory", listAll(listing{paged: true})},
+		{"POST", "/v1/*/inventory/*", adjustInventory},
+
+		{"POST", "/v1/*/modifier-lists", createModifierList},
+		{"GET", "/v1/*/modifier-lists", listAll(listing{paged: true})},
+		{"GET", "/v1/*/modifier-lists/*", retrieve},
+		{"PUT", "/v1/*/modifier-lists/*", update(false)},
+		{"DELETE", "/v1/*/modifier-lists/*", remove},
+		{"POST", "/v1/*/modifier-lists/*/modifier-options", createModifierOption},
+		{"PUT", "/v1/*/modifier-lists/*/modifier-options/*", update(false)},
+		{"DELETE", "/v1/*/modifier-lists/*/modifier-options/*", deleteModifierOption},
+
+		{"POST", "/v1/*/categories", create("CATEGORY", false, nil)},
+		{"GET", "/v1/*/categories", listAll(listing{paged: true})},
+		{"PUT", "/v1/*/categories/*", update(false)},
+		{"DELETE", "/v1/*/categories/*", remove},
+
+		{"POST", "/v1/*/discounts", create("DISCOUNT", false, nil)},
+		{"GET", "/v1/*/discounts", listAll(listing{paged: true})},
+		{"PUT", "/v1/*/discounts/*", update(false)},
+		{"DELETE", "/v1/*/discounts/*", remove},
+
+		{"POST", "/v1/*/fees", create("FEE", false, nil)},
+		{"GET", "/v1/*/fees", listAll(listing{paged: true})},
+		{"PUT", "/v1/*/fees/*", update(false)},
+		{"DELETE", "/v1/*/fees/*", remove},
+
+		{"POST", "/v1/*/pages", create("PAGE", false, defaults{"cells": []interface{}{}})},
+		{"GET", "/v1/*/pages", listAll(listing{paged: true})},
+		{"PUT", "/v1/*/pages/*", update(false)},
+		{"DELETE", "/v1/*/pages/*", remove},
+		{"PUT", "/v1/*/pages/*/cells", updateCell},
+		{"DELETE", "/v1/*/pages/*/cells", deleteCell},
+
+		{"GET", "/v1/*/webhooks", listWebhooks},
+		{"PUT", "/v1/*/webhooks", updateWebhooks},
+
+		{"POST", "/v1/batch", submitBatch},
+
+		{"POST", "/oauth2/token", getToken},
+		{"POST", "/oauth2/clients/*/access-token/renew", renewToken},
+		{"GET", "/oauth2/clients/*/subscriptions", listAll(listing{
+			equal: map[string]string{"merchant_id": "merchant_id"},
+			paged: true,
+		})},
+		{"GET", "/oauth2/clients/*/subscriptions/*", retrieve},
+		{"GET", "/oauth2/clients/*/plans", listAll(listing{})},
+		{"GET", "/oauth2/clients/*/plans/*", retrieve},
+	}
+}
+
+// serve authorizes c and routes it to its handler.
+func (s *Server) serve(c *call) *reply {
+	segs := strings.Split(strings.Trim(c.path, "/"), "/")
+	found := false
+	for _, rt := range routes {
+		args, ok := match(rt.pattern, segs)
+		if !ok {
+			continue
+		}
+		found = true
+		if rt.method != c.method {
+			continue
+		}
+		if rep := s.authorize(c); rep != nil {
+			return rep
+		}
+		return rt.handle(s, c, args)
+	}
+	if found {
+		return errorReply(http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s is not allowed on %s", c.method, c.path))
+	}
+	return errorReply(http.StatusNotFound, "", fmt.Sprintf("no endpoint at %s", c.path))
+}
+
+func match(pattern string, segs []string) ([]string, bool) {
+	pat := strings.Split(strings.Trim(pattern, "/"), "/")
+	if len(pat) != len(segs) {
+		return nil, false
+	}
+	var args []string
+	for i, p := range pat {
+		switch {
+		case p == "*":
+			args = append(args, segs[i])
+		case p != segs[i]:
+			return nil, false
+		}
+	}
+	return args, true
+}
+
+// authorize returns an error reply if c's credentials aren't accepted. OAuth
+// endpoints take an application secret, the batch endpoint takes a token per
+// batched request and every other endpoint takes an access token.
+func (s *Server) authorize(c *call) *reply {
+	if c.path == "/v1/batch" {
+		return nil
+	}
+	scheme, cred, _ := strings.Cut(c.auth, " ")
+	if strings.HasPrefix(c.path, "/oauth2/") {
+		if scheme != "Client" || len(cred) == 0 {
+			return errorReply(http.StatusUnauthorized, "", "an application secret is required")
+		}
+		return nil
+	}
+	if scheme != "Bearer" || len(cred) == 0 {
+		return errorReply(http.StatusUnauthorized, "", "an access token is required")
+	}
+	if s.tokens != nil && !s.tokens[cred] {
+		return errorReply(http.StatusUnauthorized, "", "the access token is not valid")
+	}
+	return nil
+}
+
+// defaults are the fields set on created objects that don't have them.
+type defaults map[string]interface{}
+
+// decode decodes c's body as an object.
+func (c *call) decode() (object, *reply) {
+	obj, err := decodeObject(c.body)
+	if err != nil {
+		return nil, errorReply(http.StatusBadRequest, "", fmt.Sprintf("invalid request body: %s", err))
+	}
+	return obj, nil
+}
+
+// create returns a handler adding the object in the body to the collection
+// at the call's path. Objects are given an id with prefix if they have none,
+// and creation and update times if timestamps is true.
+func create(prefix string, timestamps bool, defs defaults) handler {
+	return func(s *Server, c *call, args []string) *reply {
+		obj, rep := c.decode()
+		if rep != nil {
+			return rep
+		}
+		if rep := s.add(c.path, prefix, obj, timestamps, defs); rep != nil {
+			return rep
+		}
+		return okReply(obj)
+	}
+}
+
+func (s *Server) add(collPath, prefix string, obj object, timestamps bool, defs defaults) *reply {
+	coll := s.coll(collPath)
+	id := str(obj[coll.idKey])
+	if len(id) == 0 {
+		obj[coll.idKey] = s.nextID(prefix)
+	} else if _, ok := coll.get(id); ok {
+		return errorReply(http.StatusConflict, "conflict", fmt.Sprintf("an object with id %s already exists", id))
+	}
+	for k, v := range defs {
+		if isZero(obj[k]) {
+			obj[k] = v
+		}
+	}
+	if timestamps {
+		now := s.timestamp()
+		if isZero(obj["created_at"]) {
+			obj["created_at"] = now
+		}
+		obj["updated_at"] = now
+	}
+	coll.put(obj)
+	return nil
+}
+
+func listAll(l listing) handler {
+	return func(s *Server, c *call, args []string) *reply {
+		return s.list(c, s.coll(c.path).all(), l)
+	}
+}
+
+// lookup returns the object at the call's path.
+func (s *Server) lookup(c *call) (object, *reply) {
+	obj, ok := s.coll(path.Dir(c.path)).get(path.Base(c.path))
+	if !ok {
+		return nil, notFound(c.path)
+	}
+	return obj, nil
+}
+
+func notFound(p string) *reply {
+	return errorReply(http.StatusNotFound, "", fmt.Sprintf("%s not found", p))
+}
+
+func retrieve(s *Server, c *call, args []string) *reply {
+	obj, rep := s.lookup(c)
+	if rep != nil {
+		return rep
+	}
+	return okReply(obj)
+}
+
+// update returns a handler merging the fields of the body into
+// the object at the call's path.
+func update(timestamps bool) handler {
+	return func(s *Server, c *call, args []string) *reply {
+		obj, rep := s.merge(c)
+		if rep != nil {
+			return rep
+		}
+		if timestamps {
+			obj["updated_at"] = s.timestamp()
+		}
+		return okReply(obj)
+	}
+}
+
+func (s *Server) merge(c *call) (object, *reply) {
+	obj, rep := s.lookup(c)
+	if rep != nil {
+		return nil, rep
+	}
+	fields, rep := c.decode()
+	if rep != nil {
+		return nil, rep
+	}
+	idKey := s.coll(path.Dir(c.path)).idKey
+	for k, v := range fields {
+		if k != idKey {
+			obj[k] = v
+		}
+	}
+	return obj, nil
+}
+
+func remove(s *Server, c *call, args []string) *reply {
+	obj, ok := s.coll(path.Dir(c.path)).remove(path.Base(c.path))
+	if !ok {
+		return notFound(c.path)
+	}
+	return okReply(obj)
+}
+
+func retrieveMerchant(s *Server, c *call, args []string) *reply {
+	return okReply(s.merchant)
+}
+
+func createTimecard(s *Server, c *call, args []string) *reply {
+	obj, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	if len(str(obj["employee_id"])) == 0 {
+		return errorReply(http.StatusBadRequest, "", "employee_id is required")
+	}
+	obj["deleted"] = false
+	if rep := s.add(c.path, "TIMECARD", obj, true, defaults{"clockin_time": s.timestamp()}); rep != nil {
+		return rep
+	}
+	s.addTimecardEvent(obj, "API_CREATE")
+	return okReply(obj)
+}
+
+func updateTimecard(s *Server, c *call, args []string) *reply {
+	obj, rep := s.merge(c)
+	if rep != nil {
+		return rep
+	}
+	obj["updated_at"] = s.timestamp()
+	s.addTimecardEvent(obj, "API_EDIT")
+	return okReply(obj)
+}
+
+// deleteTimecard marks the timecard deleted, as Square does.
+func deleteTimecard(s *Server, c *call, args []string) *reply {
+	obj, rep := s.lookup(c)
+	if rep != nil {
+		return rep
+	}
+	obj["deleted"] = true
+	obj["updated_at"] = s.timestamp()
+	s.addTimecardEvent(obj, "API_DELETE")
+	return okReply(object{})
+}
+
+func (s *Server) addTimecardEvent(timecard object, eventType string) {
+	s.add("/v1/me/timecards/"+str(timecard["id"])+"/events", "TIMECARD_EVENT", object{
+		"event_type":    eventType,
+		"clockin_time":  timecard["clockin_time"],
+		"clockout_time": timecard["clockout_time"],
+		"created_at":    s.timestamp(),
+	}, false, nil)
+}
+
+func createRefund(s *Server, c *call, args []string) *reply {
+	obj, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	paymentID := str(obj["payment_id"])
+	payment, ok := s.coll("/v1/" + args[0] + "/payments").get(paymentID)
+	if !ok {
+		return errorReply(http.StatusNotFound, "", fmt.Sprintf("payment %q not found", paymentID))
+	}
+	switch str(obj["type"]) {
+	case "FULL":
+		money, _ := payment["total_collected_money"].(object)
+		obj["refunded_money"] = object{
+			"amount":        -moneyAmount(money),
+			"currency_code": money["currency_code"],
+		}
+	case "PARTIAL":
+		money, _ := obj["refunded_money"].(object)
+		if moneyAmount(money) >= 0 {
+			return errorReply(http.StatusBadRequest, "", "refunded_money must be negative for a PARTIAL refund")
+		}
+	default:
+		return errorReply(http.StatusBadRequest, "", "type must be FULL or PARTIAL")
+	}
+	delete(obj, "request_idempotence_key")
+	now := s.timestamp()
+	obj["created_at"] = now
+	obj["processed_at"] = now
+	if rep := s.add(c.path, "REFUND", obj, false, nil); rep != nil {
+		return rep
+	}
+	refunds, _ := payment["refunds"].([]interface{})
+	payment["refunds"] = append(refunds, obj)
+	return okReply(obj)
+}
+
+func moneyAmount(money object) int {
+	n, _ := strconv.Atoi(str(money["amount"]))
+	return n
+}
+
+var orderActions = map[string]struct{ state, note string }{
+	"COMPLETE": {"COMPLETED", "completed_note"},
+	"CANCEL":   {"CANCELED", "canceled_note"},
+	"REFUND":   {"REFUNDED", "refunded_note"},
+}
+
+func updateOrder(s *Server, c *call, args []string) *reply {
+	order, rep := s.lookup(c)
+	if rep != nil {
+		return rep
+	}
+	obj, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	action := str(obj["action"])
+	a, ok := orderActions[action]
+	if !ok {
+		return errorReply(http.StatusBadRequest, "", fmt.Sprintf("invalid action %q", action))
+	}
+	now := s.timestamp()
+	order["state"] = a.state
+	order[a.note] = obj[a.note]
+	order["updated_at"] = now
+	history, _ := order["order_history"].([]interface{})
+	order["order_history"] = append(history, object{"action": action, "created_at": now})
+	return okReply(order)
+}
+
+func createItem(s *Server, c *call, args []string) *reply {
+	obj, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	if rep := s.add(c.path, "ITEM", obj, false, defaults{
+		"variations":     []interface{}{},
+		"modifier_lists": []interface{}{},
+		"fees":           []interface{}{},
+	}); rep != nil {
+		return rep
+	}
+	for _, v := range objects(obj["variations"]) {
+		if isZero(v["id"]) {
+			v["id"] = s.nextID("VARIATION")
+		}
+		v["item_id"] = obj["id"]
+	}
+	s.setCategory(args[0], obj)
+	return okReply(obj)
+}
+
+func updateItem(s *Server, c *call, args []string) *reply {
+	obj, rep := s.merge(c)
+	if rep != nil {
+		return rep
+	}
+	s.setCategory(args[0], obj)
+	return okReply(obj)
+}
+
+// setCategory replaces the category_id of an item with its category.
+func (s *Server) setCategory(locationID string, item object) {
+	id := str(item["category_id"])
+	delete(item, "category_id")
+	if len(id) == 0 {
+		return
+	}
+	if cat, ok := s.coll("/v1/" + locationID + "/categories").get(id); ok {
+		item["category"] = cat
+	}
+}
+
+// itemAt returns the item a call's path is under.
+func (s *Server) itemAt(locationID, itemID string) (object, *reply) {
+	item, ok := s.coll("/v1/" + locationID + "/items").get(itemID)
+	if !ok {
+		return nil, errorReply(http.StatusNotFound, "", fmt.Sprintf("item %q not found", itemID))
+	}
+	return item, nil
+}
+
+func uploadItemImage(s *Server, c *call, args []string) *reply {
+	item, rep := s.itemAt(args[0], args[1])
+	if rep != nil {
+		return rep
+	}
+	if len(c.body) == 0 {
+		return errorReply(http.StatusBadRequest, "", "an image is required")
+	}
+	id := s.nextID("IMAGE")
+	img := object{"id": id, "url": s.URL + "/images/" + id}
+	item["master_image"] = img
+	return okReply(img)
+}
+
+func createVariation(s *Server, c *call, args []string) *reply {
+	item, rep := s.itemAt(args[0], args[1])
+	if rep != nil {
+		return rep
+	}
+	obj, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	if isZero(obj["id"]) {
+		obj["id"] = s.nextID("VARIATION")
+	}
+	obj["item_id"] = item["id"]
+	variations, _ := item["variations"].([]interface{})
+	item["variations"] = append(variations, obj)
+	return okReply(obj)
+}
+
+func findVariation(item object, id string) (int, object) {
+	for i, v := range objects(item["variations"]) {
+		if str(v["id"]) == id {
+			return i, v
+		}
+	}
+	return -1, nil
+}
+
+func updateVariation(s *Server, c *call, args []string) *reply {
+	item, rep := s.itemAt(args[0], args[1])
+	if rep != nil {
+		return rep
+	}
+	_, v := findVariation(item, args[2])
+	if v == nil {
+		return notFound(c.path)
+	}
+	obj, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	for k, f := range obj {
+		if k != "id" && k != "item_id" {
+			v[k] = f
+		}
+	}
+	return okReply(v)
+}
+
+func deleteVariation(s *Server, c *call, args []string) *reply {
+	item, rep := s.itemAt(args[0], args[1])
+	if rep != nil {
+		return rep
+	}
+	i, v := findVariation(item, args[2])
+	if v == nil {
+		return notFound(c.path)
+	}
+	variations := item["variations"].([]interface{})
+	if len(variations) == 1 {
+		return errorReply(http.StatusBadRequest, "", "an item must have at least one variation")
+	}
+	item["variations"] = append(variations[:i:i], variations[i+1:]...)
+	return okReply(v)
+}
+
+// applyToItem returns a handler adding an object of the given collection
+// of the location to a list field of an item.
+func applyToItem(field, collName string) handler {
+	return func(s *Server, c *call, args []string) *reply {
+		item, rep := s.itemAt(args[0], args[1])
+		if rep != nil {
+			return rep
+		}
+		obj, ok := s.coll("/v1/" + args[0] + "/" + collName).get(args[2])
+		if !ok {
+			return notFound(fmt.Sprintf("/v1/%s/%s/%s", args[0], collName, args[2]))
+		}
+		list, _ := item[field].([]interface{})
+		for _, o := range objects(list) {
+			if str(o["id"]) == args[2] {
+				return okReply(item)
+			}
+		}
+		item[field] = append(list, obj)
+		return okReply(item)
+	}
+}
+
+func removeFromItem(field string) handler {
+	return func(s *Server, c *call, args []string) *reply {
+		item, rep := s.itemAt(args[0], args[1])
+		if rep != nil {
+			return rep
+		}
+		list, _ := item[field].([]interface{})
+		for i, o := range objects(list) {
+			if str(o["id"]) == args[2] {
+				item[field] = append(list[:i:i], list[i+1:]...)
+				return okReply(item)
+			}
+		}
+		return notFound(c.path)
+	}
+}
+
+// adjustInventory adjusts the inventory of a variation of an item
+// of the location, which starts at 0.
+func adjustInventory(s *Server, c *call, args []string) *reply {
+	obj, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	delta, err := strconv.Atoi(str(obj["quantity_delta"]))
+	if err != nil {
+		return errorReply(http.StatusBadRequest, "", "quantity_delta must be an integer")
+	}
+	inventory := s.coll("/v1/" + args[0] + "/inventory")
+	entry, ok := inventory.get(args[1])
+	if !ok {
+		found := false
+		for _, item := range s.coll("/v1/" + args[0] + "/items").all() {
+			if _, v := findVariation(item, args[1]); v != nil {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errorReply(http.StatusNotFound, "", fmt.Sprintf("variation %q not found", args[1]))
+		}
+		entry = object{"variation_id": args[1], "quantity_on_hand": 0}
+		inventory.put(entry)
+	}
+	qty, _ := strconv.Atoi(str(entry["quantity_on_hand"]))
+	entry["quantity_on_hand"] = qty + delta
+	return okReply(entry)
+}
+
+// createModifierList stores the options of the list with the other options,
+// the list keeping their ids.
+func createModifierList(s *Server, c *call, args []string) *reply {
+	obj, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	options := objects(obj["modifier_options"])
+	if rep := s.add(c.path, "MODIFIER_LIST", obj, false, defaults{"selection_type": "SINGLE"}); rep != nil {
+		return rep
+	}
+	ids := make([]interface{}, 0, len(options))
+	for _, o := range options {
+		o["modifier_list_id"] = obj["id"]
+		if rep := s.add(c.path+"/"+str(obj["id"])+"/modifier-options", "MODIFIER_OPTION", o, false, nil); rep != nil {
+			return rep
+		}
+		ids = append(ids, o["id"])
+	}
+	obj["modifier_options"] = ids
+	return okReply(obj)
+}
+
+func createModifierOption(s *Server, c *call, args []string) *reply {
+	list, ok := s.coll(path.Dir(c.path)).get(args[1])
+	if !ok {
+		return notFound(path.Dir(c.path))
+	}
+	obj, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	obj["modifier_list_id"] = list["id"]
+	if rep := s.add(c.path, "MODIFIER_OPTION", obj, false, nil); rep != nil {
+		return rep
+	}
+	ids, _ := list["modifier_options"].([]interface{})
+	list["modifier_options"] = append(ids, obj["id"])
+	return okReply(obj)
+}
+
+func deleteModifierOption(s *Server, c *call, args []string) *reply {
+	rep := remove(s, c, args)
+	if rep.status != http.StatusOK {
+		return rep
+	}
+	if list, ok := s.coll("/v1/" + args[0] + "/modifier-lists").get(args[1]); ok {
+		ids, _ := list["modifier_options"].([]interface{})
+		for i, id := range ids {
+			if str(id) == args[2] {
+				list["modifier_options"] = append(ids[:i:i], ids[i+1:]...)
+				break
+			}
+		}
+	}
+	return rep
+}
+
+func (s *Server) pageAt(c *call, locationID, pageID string) (object, *reply) {
+	page, ok := s.coll("/v1/" + locationID + "/pages").get(pageID)
+	if !ok {
+		return nil, notFound(path.Dir(c.path))
+	}
+	return page, nil
+}
+
+func updateCell(s *Server, c *call, args []string) *reply {
+	page, rep := s.pageAt(c, args[0], args[1])
+	if rep != nil {
+		return rep
+	}
+	cell, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	cell["page_id"] = page["id"]
+	cells, _ := page["cells"].([]interface{})
+	for i, o := range objects(cells) {
+		if str(o["row"]) == str(cell["row"]) && str(o["column"]) == str(cell["column"]) {
+			cells[i] = cell
+			return okReply(cell)
+		}
+	}
+	page["cells"] = append(cells, cell)
+	return okReply(cell)
+}
+
+func deleteCell(s *Server, c *call, args []string) *reply {
+	page, rep := s.pageAt(c, args[0], args[1])
+	if rep != nil {
+		return rep
+	}
+	row, column := c.param("row"), c.param("column")
+	cells, _ := page["cells"].([]interface{})
+	for i, o := range objects(cells) {
+		if str(o["row"]) == row && str(o["column"]) == column {
+			page["cells"] = append(cells[:i:i], cells[i+1:]...)
+			return okReply(o)
+		}
+	}
+	return errorReply(http.StatusNotFound, "", fmt.Sprintf("no cell at row %s, column %s", row, column))
+}
+
+func listWebhooks(s *Server, c *call, args []string) *reply {
+	events := s.webhooks[args[0]]
+	if events == nil {
+		events = []string{}
+	}
+	return okReply(events)
+}
+
+func updateWebhooks(s *Server, c *call, args []string) *reply {
+	var events []string
+	if err := json.Unmarshal(c.body, &events); err != nil || events == nil {
+		return errorReply(http.StatusBadRequest, "", "the body must be an array of event types")
+	}
+	s.webhooks[args[0]] = events
+	return okReply(events)
+}
+
+// maxBatch is the number of requests Square accepts in a batch.
+const maxBatch = 30
+
+func submitBatch(s *Server, c *call, args []string) *reply {
+	var batch struct {
+		Requests []struct {
+			Method       string          `json:"method"`
+			RelativePath string          `json:"relative_path"`
+			AccessToken  string          `json:"access_token"`
+			Body         json.RawMessage `json:"body"`
+			RequestID    string          `json:"request_id"`
+		} `json:"requests"`
+	}
+	if err := json.Unmarshal(c.body, &batch); err != nil {
+		return errorReply(http.StatusBadRequest, "", fmt.Sprintf("invalid request body: %s", err))
+	}
+	if len(batch.Requests) > maxBatch {
+		return errorReply(http.StatusBadRequest, "", fmt.Sprintf("a batch can't have more than %d requests", maxBatch))
+	}
+	resps := make([]object, len(batch.Requests))
+	for i, br := range batch.Requests {
+		var rep *reply
+		u, err := url.Parse(br.RelativePath)
+		switch {
+		case err != nil:
+			rep = errorReply(http.StatusBadRequest, "", fmt.Sprintf("invalid relative_path %q", br.RelativePath))
+		case u.Path == c.path:
+			rep = errorReply(http.StatusBadRequest, "", "batches can't be nested")
+		default:
+			sub := &call{
+				method:  br.Method,
+				path:    u.Path,
+				query:   u.Query(),
+				auth:    "Bearer " + br.AccessToken,
+				body:    br.Body,
+				batched: true,
+			}
+			s.requests = append(s.requests, Request{Method: sub.method, Path: u.RequestURI(), Batched: true})
+			if f := s.matchFault(sub); f != nil {
+				rep = f.reply()
+			}
+			if rep == nil {
+				rep = s.serve(sub)
+			}
+		}
+		headers := make(map[string]string, len(rep.header))
+		for k := range rep.header {
+			headers[k] = rep.header.Get(k)
+		}
+		resps[i] = object{
+			"status_code": rep.status,
+			"headers":     headers,
+			"body":        rep.body,
+			"request_id":  br.RequestID,
+		}
+	}
+	return okReply(resps)
+}
+
+func getToken(s *Server, c *call, args []string) *reply {
+	obj, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	if len(str(obj["code"])) == 0 {
+		return errorReply(http.StatusBadRequest, "", "an authorization code is required")
+	}
+	return okReply(s.issueToken())
+}
+
+func renewToken(s *Server, c *call, args []string) *reply {
+	obj, rep := c.decode()
+	if rep != nil {
+		return rep
+	}
+	token := str(obj["access_token"])
+	if len(token) == 0 || (s.tokens != nil && !s.tokens[token]) {
+		return errorReply(http.StatusUnauthorized, "", "the access token is not valid")
+	}
+	return okReply(s.issueToken())
+}
+
+func (s *Server) issueToken() object {
+	token := newID()
+	if s.tokens != nil {
+		s.tokens[token] = true
+	}
+	return object{
+		"access_token": token,
+		"token_type":   "bearer",
+		"expires_at":   s.now().Add(30 * 24 * time.Hour).UTC().Format(time.RFC3339),
+		"merchant_id":  s.merchant["id"],
+	}
+}
+
+// objects returns the objects in a JSON array.
+func objects(v interface{}) []object {
+	list, _ := v.([]interface{})
+	objs := make([]object, 0, len(list))
+	for _, o := range list {
+		if obj, ok := o.(object); ok {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
+
+func isZero(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return len(t) == 0
+	case []interface{}:
+		return t == nil
+	}
+	return false
+}
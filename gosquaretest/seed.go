@@ -0,0 +1,161 @@
+package gosquaretest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nathanjsweet/gosquare"
+)
+
+// Seed adds objects to the collection listed at path, for example
+// "/v1/LOCATION_ID/payments", as if they had been created through the API.
+// Objects are given an id if they have none. Seed returns an error if an
+// object isn't encoded as a JSON object or if its id is already taken.
+//
+// The typed Seed methods cover every collection gosquare lists.
+func (s *Server) Seed(path string, objs ...interface{}) error {
+	path = "/" + strings.Trim(path, "/")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prefix := strings.ToUpper(strings.ReplaceAll(path[strings.LastIndex(path, "/")+1:], "-", "_"))
+	for _, v := range objs {
+		bts, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		obj, err := decodeObject(bts)
+		if err != nil {
+			return fmt.Errorf("gosquaretest: seeding %s: %w", path, err)
+		}
+		if rep := s.add(path, prefix, obj, false, nil); rep != nil {
+			return fmt.Errorf("gosquaretest: seeding %s: %v", path, rep.body)
+		}
+	}
+	return nil
+}
+
+func seed[T any](s *Server, path string, objs []*T) {
+	vs := make([]interface{}, len(objs))
+	for i, o := range objs {
+		vs[i] = o
+	}
+	if err := s.Seed(path, vs...); err != nil {
+		panic(err)
+	}
+}
+
+// SetMerchant sets the merchant returned by /v1/me.
+func (s *Server) SetMerchant(m *gosquare.Merchant) {
+	obj := toObject(m)
+	s.mu.Lock()
+	s.merchant = obj
+	s.mu.Unlock()
+}
+
+// SeedLocations adds locations of the merchant.
+func (s *Server) SeedLocations(locations ...*gosquare.Merchant) {
+	seed(s, "/v1/me/locations", locations)
+}
+
+// SeedEmployees adds employees.
+func (s *Server) SeedEmployees(employees ...*gosquare.Employee) {
+	seed(s, "/v1/me/employees", employees)
+}
+
+// SeedRoles adds employee roles.
+func (s *Server) SeedRoles(roles ...*gosquare.EmployeeRole) {
+	seed(s, "/v1/me/roles", roles)
+}
+
+// SeedTimecards adds timecards.
+func (s *Server) SeedTimecards(timecards ...*gosquare.Timecard) {
+	seed(s, "/v1/me/timecards", timecards)
+}
+
+// SeedCashDrawerShifts adds cash drawer shifts to a location.
+func (s *Server) SeedCashDrawerShifts(locationID string, shifts ...*gosquare.CashDrawerShift) {
+	seed(s, "/v1/"+locationID+"/cash-drawer-shifts", shifts)
+}
+
+// SeedPayments adds payments to a location.
+func (s *Server) SeedPayments(locationID string, payments ...*gosquare.Payment) {
+	seed(s, "/v1/"+locationID+"/payments", payments)
+}
+
+// SeedSettlements adds settlements to a location.
+func (s *Server) SeedSettlements(locationID string, settlements ...*gosquare.Settlement) {
+	seed(s, "/v1/"+locationID+"/settlements", settlements)
+}
+
+// SeedRefunds adds refunds to a location.
+func (s *Server) SeedRefunds(locationID string, refunds ...*gosquare.Refund) {
+	seed(s, "/v1/"+locationID+"/refunds", refunds)
+}
+
+// SeedOrders adds orders to a location.
+func (s *Server) SeedOrders(locationID string, orders ...*gosquare.Order) {
+	seed(s, "/v1/"+locationID+"/orders", orders)
+}
+
+// SeedBankAccounts adds bank accounts to a location.
+func (s *Server) SeedBankAccounts(locationID string, accounts ...*gosquare.BankAccount) {
+	seed(s, "/v1/"+locationID+"/bank-accounts", accounts)
+}
+
+// SeedItems adds items to a location.
+func (s *Server) SeedItems(locationID string, items ...*gosquare.Item) {
+	seed(s, "/v1/"+locationID+"/items", items)
+}
+
+// SeedInventory adds inventory entries to a location.
+func (s *Server) SeedInventory(locationID string, entries ...*gosquare.InventoryEntry) {
+	seed(s, "/v1/"+locationID+"/inventory", entries)
+}
+
+// SeedModifierLists adds modifier lists to a location.
+func (s *Server) SeedModifierLists(locationID string, lists ...*gosquare.ModifierList) {
+	seed(s, "/v1/"+locationID+"/modifier-lists", lists)
+}
+
+// SeedModifierOptions adds options to a modifier list of a location.
+func (s *Server) SeedModifierOptions(locationID, modifierListID string, options ...*gosquare.ModifierOption) {
+	seed(s, "/v1/"+locationID+"/modifier-lists/"+modifierListID+"/modifier-options", options)
+}
+
+// SeedCategories adds categories to a location.
+func (s *Server) SeedCategories(locationID string, categories ...*gosquare.Category) {
+	seed(s, "/v1/"+locationID+"/categories", categories)
+}
+
+// SeedDiscounts adds discounts to a location.
+func (s *Server) SeedDiscounts(locationID string, discounts ...*gosquare.Discount) {
+	seed(s, "/v1/"+locationID+"/discounts", discounts)
+}
+
+// SeedFees adds fees to a location.
+func (s *Server) SeedFees(locationID string, fees ...*gosquare.Fee) {
+	seed(s, "/v1/"+locationID+"/fees", fees)
+}
+
+// SeedPages adds Square Register pages to a location.
+func (s *Server) SeedPages(locationID string, pages ...*gosquare.Page) {
+	seed(s, "/v1/"+locationID+"/pages", pages)
+}
+
+// SetWebhooks sets the event types that trigger webhooks for a location.
+func (s *Server) SetWebhooks(locationID string, eventTypes ...string) {
+	s.mu.Lock()
+	s.webhooks[locationID] = append([]string{}, eventTypes...)
+	s.mu.Unlock()
+}
+
+// SeedSubscriptions adds subscriptions to an application.
+func (s *Server) SeedSubscriptions(clientID string, subscriptions ...*gosquare.Subscription) {
+	seed(s, "/oauth2/clients/"+clientID+"/subscriptions", subscriptions)
+}
+
+// SeedSubscriptionPlans adds subscription plans to an application.
+func (s *Server) SeedSubscriptionPlans(clientID string, plans ...*gosquare.SubscriptionPlan) {
+	seed(s, "/oauth2/clients/"+clientID+"/plans", plans)
+}
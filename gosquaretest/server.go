@@ -0,0 +1,379 @@
+// Package gosquaretest provides an in-memory fake of the Square Connect v1 API,
+// so code built on gosquare can be tested end to end without Square.
+//
+//	srv := gosquaretest.NewServer()
+//	defer srv.Close()
+//	srv.SeedPayments("LOCATION_ID", &gosquare.Payment{ID: "P1", CreatedAt: "2016-01-02T15:04:05Z"})
+//	client := srv.Client()
+//	payments, next, err := client.ListPayments("", "LOCATION_ID", "", "", "", 0)
+//
+// The Server implements every route gosquare calls, /v1/batch included, and
+// keeps what is created, updated and deleted through them. List endpoints are
+// paginated with Link headers like Square's, and errors have Square's
+// {"type", "message"} bodies. Faults can be injected to exercise retries and
+// error handling, and every request is recorded, see Server.Requests.
+package gosquaretest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nathanjsweet/gosquare"
+)
+
+// DefaultToken is the access token of the Client returned by Server.Client.
+const DefaultToken = "FAKE_ACCESS_TOKEN"
+
+// DefaultPageSize is the number of objects per page returned by list
+// endpoints when the request doesn't set a limit.
+const DefaultPageSize = 100
+
+// Server is a fake Square Connect v1 API listening on a local address.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	colls    map[string]*collection
+	merchant object
+	webhooks map[string][]string
+	tokens   map[string]bool
+	pageSize int
+	now      func() time.Time
+	faults   []*fault
+	requests []Request
+	seq      int
+}
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+	// Path is the path of the request, query included.
+	Path string
+	// Batched is true for the requests of a /v1/batch request,
+	// which is recorded as well.
+	Batched bool
+}
+
+// Fault makes a Server fail the requests it matches, see Server.InjectFault.
+type Fault struct {
+	// Method is the method of the requests to fail, any method if empty.
+	Method string
+	// Path is a prefix of the paths, without query, of the requests
+	// to fail, any path if empty.
+	Path string
+	// StatusCode is the status of the error returned.
+	// It defaults to 500, unless the Fault only has a Delay.
+	StatusCode int
+	// Type and Message are the fields of the error body returned,
+	// derived from StatusCode if empty.
+	Type    string
+	Message string
+	// RetryAfter, if positive, is sent as the Retry-After header.
+	RetryAfter time.Duration
+	// Delay delays the response, or the error. A Fault with only
+	// a Delay slows requests down without failing them.
+	Delay time.Duration
+	// CloseConnection closes the connection without a response, which
+	// fails the request with a transport error.
+	CloseConnection bool
+	// Times is the number of requests failed before the Fault is
+	// removed, 0 to fail every matching request.
+	Times int
+}
+
+type fault struct {
+	Fault
+	left int
+}
+
+// NewServer starts and returns a Server, which should be closed when done.
+func NewServer() *Server {
+	s := &Server{
+		colls:    make(map[string]*collection),
+		webhooks: make(map[string][]string),
+		pageSize: DefaultPageSize,
+		now:      time.Now,
+	}
+	s.merchant = toObject(&gosquare.Merchant{
+		ID:           "MERCHANT_ID",
+		Name:         "Fake Merchant",
+		AccountType:  "BUSINESS",
+		CountryCode:  "US",
+		LanguageCode: "en-US",
+		CurrencyCode: "USD",
+		BusinessName: "Fake Merchant",
+	})
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Client returns a gosquare.Client sending its requests to the Server,
+// with DefaultToken as its access token.
+func (s *Server) Client() *gosquare.Client {
+	c := gosquare.NewClient(s.URL, DefaultToken)
+	c.HTTPClient = s.Server.Client()
+	return c
+}
+
+// SetTokens restricts the access tokens the Server accepts, requests with
+// any other token fail with a 401. By default any token is accepted. Tokens
+// issued through /oauth2/token and renewed are accepted too.
+func (s *Server) SetTokens(tokens ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = make(map[string]bool, len(tokens))
+	for _, t := range tokens {
+		s.tokens[t] = true
+	}
+}
+
+// SetPageSize sets the number of objects per page of list endpoints
+// when the request doesn't set a limit.
+func (s *Server) SetPageSize(n int) {
+	s.mu.Lock()
+	s.pageSize = n
+	s.mu.Unlock()
+}
+
+// SetNow sets the clock used to timestamp created and updated objects.
+func (s *Server) SetNow(now func() time.Time) {
+	s.mu.Lock()
+	s.now = now
+	s.mu.Unlock()
+}
+
+// InjectFault makes the Server fail the requests f matches. Faults are
+// matched in the order they are injected. The requests of a /v1/batch
+// request are matched too, failing only their own responses, but Delay
+// and CloseConnection are ignored for them.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	s.faults = append(s.faults, &fault{Fault: f, left: f.Times})
+	s.mu.Unlock()
+}
+
+// ClearFaults removes every injected Fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	s.faults = nil
+	s.mu.Unlock()
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeReply(w, errorReply(http.StatusBadRequest, "", err.Error()))
+		return
+	}
+	c := &call{
+		method: r.Method,
+		path:   r.URL.Path,
+		query:  r.URL.Query(),
+		auth:   r.Header.Get("Authorization"),
+		body:   body,
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.RequestURI()})
+	f := s.matchFault(c)
+	s.mu.Unlock()
+	if f != nil {
+		if f.Delay > 0 {
+			select {
+			case <-time.After(f.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if f.CloseConnection {
+			if hj, ok := w.(http.Hijacker); ok {
+				if conn, _, err := hj.Hijack(); err == nil {
+					conn.Close()
+					return
+				}
+			}
+		}
+		if rep := f.reply(); rep != nil {
+			writeReply(w, rep)
+			return
+		}
+	}
+	s.mu.Lock()
+	rep := s.serve(c)
+	// encoded under the lock, the body may hold stored objects
+	bts := mustMarshal(rep.body)
+	s.mu.Unlock()
+	writeBody(w, rep.status, rep.header, bts)
+}
+
+// matchFault returns the first fault matching c, if any.
+func (s *Server) matchFault(c *call) *fault {
+	for i, f := range s.faults {
+		if len(f.Method) > 0 && f.Method != c.method {
+			continue
+		}
+		if !strings.HasPrefix(c.path, f.Path) {
+			continue
+		}
+		if f.Times > 0 {
+			if f.left--; f.left == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// reply returns the error reply of f, nil if it only delays requests.
+func (f *fault) reply() *reply {
+	status := f.StatusCode
+	if status == 0 {
+		if f.Delay > 0 && len(f.Type) == 0 && len(f.Message) == 0 && f.RetryAfter == 0 {
+			return nil
+		}
+		status = http.StatusInternalServerError
+	}
+	rep := errorReply(status, f.Type, f.Message)
+	if f.RetryAfter > 0 {
+		rep.header.Set("Retry-After", fmt.Sprint(int(f.RetryAfter.Round(time.Second)/time.Second)))
+	}
+	return rep
+}
+
+// call is a request being served, batched or not.
+type call struct {
+	method  string
+	path    string
+	query   map[string][]string
+	auth    string
+	body    []byte
+	batched bool
+}
+
+func (c *call) param(name string) string {
+	if v := c.query[name]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+type reply struct {
+	status int
+	header http.Header
+	body   interface{}
+}
+
+func okReply(body interface{}) *reply {
+	return &reply{status: http.StatusOK, header: make(http.Header), body: body}
+}
+
+// errorReply returns a reply with a Square error body.
+func errorReply(status int, typ, message string) *reply {
+	if len(typ) == 0 {
+		switch status {
+		case http.StatusBadRequest:
+			typ = "bad_request"
+		case http.StatusUnauthorized:
+			typ = "service.not_authorized"
+		case http.StatusForbidden:
+			typ = "forbidden"
+		case http.StatusNotFound:
+			typ = "not_found"
+		case http.StatusTooManyRequests:
+			typ = "rate_limited"
+		default:
+			typ = "internal_server_error"
+		}
+	}
+	if len(message) == 0 {
+		message = http.StatusText(status)
+	}
+	return &reply{
+		status: status,
+		header: make(http.Header),
+		body:   map[string]string{"type": typ, "message": message},
+	}
+}
+
+func writeReply(w http.ResponseWriter, rep *reply) {
+	writeBody(w, rep.status, rep.header, mustMarshal(rep.body))
+}
+
+func writeBody(w http.ResponseWriter, status int, header http.Header, body []byte) {
+	for k, v := range header {
+		w.Header()[k] = v
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-Id", newID())
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// object is a JSON object as stored by the Server.
+type object = map[string]interface{}
+
+// toObject converts v to an object through its JSON encoding.
+func toObject(v interface{}) object {
+	obj, err := decodeObject(mustMarshal(v))
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+func decodeObject(bts []byte) (object, error) {
+	var obj object
+	dec := json.NewDecoder(bytes.NewReader(bts))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("expected a JSON object")
+	}
+	return obj, nil
+}
+
+func mustMarshal(v interface{}) []byte {
+	bts, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return bts
+}
+
+func newID() string {
+	b := make([]byte, 12)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		panic(err.Error()) // rand.Reader should never fail
+	}
+	return strings.ToUpper(hex.EncodeToString(b))
+}
+
+// nextID returns a readable id, unique within the Server.
+func (s *Server) nextID(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s_%d", prefix, s.seq)
+}
+
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,533 @@
+// Command gen generates the endpoint methods of gosquare from the endpoint
+// table in table.go:
+//
+//	endpoints.go          the direct methods and their Context variants
+//	batch_endpoints.go    the BatchRequest and BatchCall builders, and the
+//	                      results of the operations of reloaded BatchPlans
+//	iter_endpoints.go     the Iter and Stream methods of the list endpoints
+//	default_endpoints.go  the package-level wrappers around DefaultClient
+//
+// It is run by go generate in the gosquare directory. With -check, it writes
+// nothing and exits with status 1 if a generated file isn't up to date.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// endpoint describes an endpoint of the Connect API.
+type endpoint struct {
+	// Name is the name of the direct method and, unless Operation is set,
+	// of the operation.
+	Name string
+	// Operation is the name of the operation, for the methods that aren't
+	// named after it.
+	Operation string
+	// Deprecated is the name of the method superseding this one, which is
+	// kept for compatibility.
+	Deprecated string
+	// Doc documents the endpoint, one line per string.
+	Doc []string
+	// Permissions are the OAuth permissions the endpoint requires.
+	Permissions []string
+	// ParamDoc documents the Query parameters, one line per string.
+	ParamDoc []string
+	// Method is the HTTP method of the endpoint.
+	Method string
+	// Path is the path of the endpoint, relative to the base url, with its
+	// parameters in braces: "/v1/{locationID}/items/{itemID}".
+	Path string
+	// Query are the parameters of the query string, passed after the path
+	// parameters to the query.go function named after the endpoint,
+	// listPaymentsPath for ListPayments.
+	Query []param
+	// Options is the options type of the WithOptions variants, which
+	// supersede the variants taking the Query parameters.
+	Options string
+	// Body is the type of the request body, "" for none.
+	Body string
+	// BodyName is the name of the body parameter. Default value: reqObj
+	BodyName string
+	// Result is the type of the response body, "" for none. GET endpoints
+	// returning a slice are paginated.
+	Result string
+	// NextRequest makes the direct methods return a NextRequest though the
+	// endpoint isn't paginated, for compatibility.
+	NextRequest bool
+}
+
+// param is a query string parameter.
+type param struct {
+	Name string
+	Type string
+	// Ref passes the address of the parameter to the path function.
+	Ref bool
+}
+
+const header = "// Code generated by go run ./internal/gen; DO NOT EDIT.\n\n"
+
+// outputs are the generated files, and the functions writing them.
+var outputs = []struct {
+	name string
+	gen  func(*bytes.Buffer)
+}{
+	{"endpoints.go", genEndpoints},
+	{"batch_endpoints.go", genBatch},
+	{"iter_endpoints.go", genIter},
+	{"default_endpoints.go", genDefaults},
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+	dir := flag.String("dir", ".", "the gosquare `directory`")
+	check := flag.Bool("check", false, "check that the generated files are up to date, without writing them")
+	flag.Parse()
+	stale := false
+	for _, out := range outputs {
+		src, err := render(out.gen)
+		if err != nil {
+			log.Fatalf("formatting %s: %v", out.name, err)
+		}
+		path := filepath.Join(*dir, out.name)
+		if *check {
+			cur, err := os.ReadFile(path)
+			if err != nil || !bytes.Equal(cur, src) {
+				log.Printf("%s is not up to date, run go generate", path)
+				stale = true
+			}
+			continue
+		}
+		if err := os.WriteFile(path, src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if stale {
+		os.Exit(1)
+	}
+}
+
+// render returns the formatted source written by gen.
+func render(gen func(*bytes.Buffer)) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteString(header)
+	gen(buf)
+	return format.Source(buf.Bytes())
+}
+
+// paged reports whether the endpoint returns its results in pages.
+func (e *endpoint) paged() bool {
+	return e.Method == "GET" && strings.HasPrefix(e.Result, "[]")
+}
+
+// op returns the name of the operation.
+func (e *endpoint) op() string {
+	if len(e.Operation) > 0 {
+		return e.Operation
+	}
+	return e.Name
+}
+
+// deprecated returns the deprecation notice of the method named e.Name+suffix,
+// "" if it isn't deprecated.
+func (e *endpoint) deprecated(suffix string) string {
+	switch {
+	case len(e.Deprecated) > 0:
+		return fmt.Sprintf("Deprecated: use %s%s instead.", e.Deprecated, suffix)
+	case len(e.Options) > 0:
+		return fmt.Sprintf("Deprecated: use %sWithOptions%s, which takes typed filters.", e.Name, suffix)
+	}
+	return ""
+}
+
+// listName is the name of the Iter and Stream methods, without their prefix.
+func (e *endpoint) listName() string {
+	return strings.TrimPrefix(e.Name, "List")
+}
+
+func (e *endpoint) bodyName() string {
+	if len(e.BodyName) > 0 {
+		return e.BodyName
+	}
+	return "reqObj"
+}
+
+var pathParam = regexp.MustCompile(`\{(\w+)\}`)
+
+// pathParams returns the names of the path parameters.
+func (e *endpoint) pathParams() []string {
+	var ps []string
+	for _, m := range pathParam.FindAllStringSubmatch(e.Path, -1) {
+		ps = append(ps, m[1])
+	}
+	return ps
+}
+
+// pathExpr returns the expression of the request path.
+func (e *endpoint) pathExpr() string {
+	ps := e.pathParams()
+	if len(e.Query) > 0 {
+		args := ps
+		for _, q := range e.Query {
+			if q.Ref {
+				args = append(args, "&"+q.Name)
+			} else {
+				args = append(args, q.Name)
+			}
+		}
+		return fmt.Sprintf("%sPath(%s)", lowerFirst(e.Name), strings.Join(args, ", "))
+	}
+	if len(ps) == 0 {
+		return fmt.Sprintf("%q", e.Path)
+	}
+	return fmt.Sprintf("fmt.Sprintf(%q, %s)", pathParam.ReplaceAllString(e.Path, "%s"), strings.Join(ps, ", "))
+}
+
+// optionsPathExpr returns the expression of the request path of the WithOptions variants.
+func (e *endpoint) optionsPathExpr() string {
+	return fmt.Sprintf("opts.path(%s)", strings.Join(e.pathParams(), ", "))
+}
+
+// variant is a set of parameters of an endpoint method.
+type variant struct {
+	names []string
+	types []string
+}
+
+func (v variant) add(name, typ string) variant {
+	return variant{append(v.names[:len(v.names):len(v.names)], name), append(v.types[:len(v.types):len(v.types)], typ)}
+}
+
+// params returns the parameter list, consecutive parameters of the same type grouped.
+func (v variant) params() string {
+	var b strings.Builder
+	for i, n := range v.names {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(n)
+		if i == len(v.names)-1 || v.types[i+1] != v.types[i] {
+			b.WriteString(" " + v.types[i])
+		}
+	}
+	return b.String()
+}
+
+func (v variant) args() string {
+	return strings.Join(v.names, ", ")
+}
+
+// base returns the token and path parameters.
+func (e *endpoint) base() variant {
+	v := variant{}.add("token", "string")
+	for _, p := range e.pathParams() {
+		v = v.add(p, "string")
+	}
+	return v
+}
+
+// positional returns the parameters of the variants taking the Query parameters.
+func (e *endpoint) positional() variant {
+	v := e.base()
+	for _, q := range e.Query {
+		v = v.add(q.Name, q.Type)
+	}
+	if len(e.Body) > 0 {
+		v = v.add(e.bodyName(), e.Body)
+	}
+	return v
+}
+
+// withOptions returns the parameters of the WithOptions variants.
+func (e *endpoint) withOptions() variant {
+	return e.base().add("opts", "*"+e.Options)
+}
+
+// results returns the results of the direct methods.
+func (e *endpoint) results() string {
+	switch {
+	case len(e.Result) == 0:
+		return "error"
+	case e.paged() || e.NextRequest:
+		return fmt.Sprintf("(%s, *NextRequest, error)", e.Result)
+	}
+	return fmt.Sprintf("(%s, error)", e.Result)
+}
+
+// newResult returns the declaration of v, the result, and the argument it
+// is passed as, "" and "nil" for endpoints without a result.
+func (e *endpoint) newResult() (string, string) {
+	switch {
+	case len(e.Result) == 0:
+		return "", "nil"
+	case strings.HasPrefix(e.Result, "[]"):
+		return fmt.Sprintf("v := make(%s, 0)", e.Result), "&v"
+	}
+	return fmt.Sprintf("v := new(%s)", strings.TrimPrefix(e.Result, "*")), "v"
+}
+
+func (e *endpoint) bodyArg() string {
+	if len(e.Body) > 0 {
+		return e.bodyName()
+	}
+	return "nil"
+}
+
+// writeDoc writes the doc comment of the direct method.
+func (e *endpoint) writeDoc(b *bytes.Buffer) {
+	var lines []string
+	lines = append(lines, e.Doc...)
+	if len(e.Permissions) > 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "Required permissions:  "+strings.Join(e.Permissions, ", "))
+	}
+	if len(e.ParamDoc) > 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, e.ParamDoc...)
+	}
+	if note := e.deprecated(""); len(note) > 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, note)
+	}
+	for _, l := range lines {
+		if len(l) == 0 {
+			b.WriteString("//\n")
+		} else {
+			fmt.Fprintf(b, "// %s\n", l)
+		}
+	}
+}
+
+func genEndpoints(b *bytes.Buffer) {
+	b.WriteString("package gosquare\n\nimport (\n\t\"context\"\n\t\"fmt\"\n)\n")
+	for i := range endpoints {
+		e := &endpoints[i]
+		if len(e.Options) > 0 {
+			genListEndpoint(b, e)
+			continue
+		}
+		v := e.positional()
+		b.WriteString("\n")
+		e.writeDoc(b)
+		fmt.Fprintf(b, "func (c *Client) %s(%s) %s {\n", e.Name, v.params(), e.results())
+		fmt.Fprintf(b, "\treturn c.%sContext(context.Background(), %s)\n}\n\n", e.Name, v.args())
+		fmt.Fprintf(b, "// %sContext is like %s but uses ctx for the request.\n", e.Name, e.Name)
+		writeNote(b, e.deprecated("Context"))
+		fmt.Fprintf(b, "func (c *Client) %sContext(ctx context.Context, %s) %s {\n", e.Name, v.params(), e.results())
+		decl, result := e.newResult()
+		if len(decl) > 0 {
+			fmt.Fprintf(b, "\t%s\n", decl)
+		}
+		call := fmt.Sprintf("c.squareRequest(ctx, %q, %q, %s, token, %s, %s)", e.op(), e.Method, e.pathExpr(), e.bodyArg(), result)
+		switch {
+		case len(e.Result) == 0:
+			fmt.Fprintf(b, "\t_, err := %s\n\tif err != nil {\n\t\treturn err\n\t}\n\treturn nil\n}\n", call)
+		case e.paged() || e.NextRequest:
+			fmt.Fprintf(b, "\tnr, err := %s\n\tif err != nil {\n\t\treturn nil, nil, err\n\t}\n\treturn v, nr, nil\n}\n", call)
+		default:
+			fmt.Fprintf(b, "\t_, err := %s\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\treturn v, nil\n}\n", call)
+		}
+	}
+}
+
+// genListEndpoint writes the methods of a list endpoint taking an options type.
+func genListEndpoint(b *bytes.Buffer, e *endpoint) {
+	v, o := e.positional(), e.withOptions()
+	list := lowerFirst(e.Name)
+	b.WriteString("\n")
+	e.writeDoc(b)
+	fmt.Fprintf(b, "func (c *Client) %s(%s) %s {\n", e.Name, v.params(), e.results())
+	fmt.Fprintf(b, "\treturn c.%sContext(context.Background(), %s)\n}\n\n", e.Name, v.args())
+	fmt.Fprintf(b, "// %sContext is like %s but uses ctx for the request.\n", e.Name, e.Name)
+	writeNote(b, e.deprecated("Context"))
+	fmt.Fprintf(b, "func (c *Client) %sContext(ctx context.Context, %s) %s {\n", e.Name, v.params(), e.results())
+	fmt.Fprintf(b, "\treturn c.%s(ctx, token, %s)\n}\n\n", list, e.pathExpr())
+	fmt.Fprintf(b, "// %sWithOptions is like %s but takes its filters as\n// a %s, nil for none.\n", e.Name, e.Name, e.Options)
+	fmt.Fprintf(b, "func (c *Client) %sWithOptions(%s) %s {\n", e.Name, o.params(), e.results())
+	fmt.Fprintf(b, "\treturn c.%sWithOptionsContext(context.Background(), %s)\n}\n\n", e.Name, o.args())
+	fmt.Fprintf(b, "// %sWithOptionsContext is like %sWithOptions but uses ctx for the request.\n", e.Name, e.Name)
+	fmt.Fprintf(b, "func (c *Client) %sWithOptionsContext(ctx context.Context, %s) %s {\n", e.Name, o.params(), e.results())
+	fmt.Fprintf(b, "\treturn c.%s(ctx, token, %s)\n}\n\n", list, e.optionsPathExpr())
+	decl, result := e.newResult()
+	fmt.Fprintf(b, "func (c *Client) %s(ctx context.Context, token, path string) %s {\n\t%s\n", list, e.results(), decl)
+	fmt.Fprintf(b, "\tnr, err := c.squareRequest(ctx, %q, %q, path, token, nil, %s)\n", e.op(), e.Method, result)
+	b.WriteString("\tif err != nil {\n\t\treturn nil, nil, err\n\t}\n\treturn v, nr, nil\n}\n")
+}
+
+// callType is the type parameter of the BatchCall of the endpoint.
+func (e *endpoint) callType() string {
+	if len(e.Result) == 0 {
+		return "struct{}"
+	}
+	return e.Result
+}
+
+func genBatch(b *bytes.Buffer) {
+	b.WriteString("package gosquare\n\nimport \"fmt\"\n")
+	for i := range endpoints {
+		e := &endpoints[i]
+		decl, result := e.newResult()
+		if len(decl) > 0 {
+			decl = "\t" + decl + "\n"
+		}
+		v := e.positional()
+		name := e.Name
+		b.WriteString("\n")
+		fmt.Fprintf(b, "// %sBatchRequest returns a BatchRequest object for %s,\n// along with a unique request id.\n", name, name)
+		writeNote(b, e.deprecated("BatchRequest"))
+		fmt.Fprintf(b, "func (c *Client) %sBatchRequest(%s) (*BatchRequest, string) {\n%s", name, v.params(), decl)
+		fmt.Fprintf(b, "\treturn c.newBatchRequest(%q, %q, %s, token, %s, %s)\n}\n", e.op(), e.Method, e.pathExpr(), e.bodyArg(), result)
+		if len(e.Options) > 0 {
+			v = e.withOptions()
+			name = e.Name + "WithOptions"
+			fmt.Fprintf(b, "\n// %sBatchRequest returns a BatchRequest object for\n// %s, along with a unique request id.\n", name, name)
+			fmt.Fprintf(b, "func (c *Client) %sBatchRequest(%s) (*BatchRequest, string) {\n%s", name, v.params(), decl)
+			fmt.Fprintf(b, "\treturn c.newBatchRequest(%q, %q, %s, token, nil, %s)\n}\n", e.op(), e.Method, e.optionsPathExpr(), result)
+		}
+		fmt.Fprintf(b, "\n// %sBatchCall is like %sBatchRequest but returns a typed BatchCall.\n", name, name)
+		if len(e.Deprecated) > 0 {
+			writeNote(b, e.deprecated("BatchCall"))
+		}
+		fmt.Fprintf(b, "func (c *Client) %sBatchCall(%s) *BatchCall[%s] {\n", name, v.params(), e.callType())
+		fmt.Fprintf(b, "\tbr, _ := c.%sBatchRequest(%s)\n\treturn &BatchCall[%s]{br}\n}\n", name, v.args(), e.callType())
+	}
+	b.WriteString("\n// newBatchResult returns a new result for a batch request of operation,\n")
+	b.WriteString("// nil for the operations without one. ok is false for unknown operations.\n")
+	b.WriteString("func newBatchResult(operation string) (result interface{}, ok bool) {\n\tswitch operation {\n")
+	for i := range endpoints {
+		e := &endpoints[i]
+		if len(e.Deprecated) > 0 {
+			// the superseding method has the same operation
+			continue
+		}
+		decl, result := e.newResult()
+		fmt.Fprintf(b, "\tcase %q:\n", e.op())
+		if result == "v" {
+			decl, result = "", strings.TrimPrefix(decl, "v := ")
+		}
+		if len(decl) > 0 {
+			fmt.Fprintf(b, "\t\t%s\n", decl)
+		}
+		fmt.Fprintf(b, "\t\treturn %s, true\n", result)
+	}
+	b.WriteString("\t}\n\treturn nil, false\n}\n")
+}
+
+// iterVariant returns the parameters and the direct method the Iter and
+// Stream methods of a list endpoint use, along with the path of its
+// first page.
+func (e *endpoint) iterVariant() (variant, string, string) {
+	if len(e.Options) > 0 {
+		return e.withOptions(), e.Name + "WithOptions", e.optionsPathExpr()
+	}
+	return e.positional(), e.Name, e.pathExpr()
+}
+
+func genIter(b *bytes.Buffer) {
+	b.WriteString("package gosquare\n\nimport (\n\t\"context\"\n\t\"fmt\"\n\t\"iter\"\n)\n")
+	for i := range endpoints {
+		e := &endpoints[i]
+		if !e.paged() {
+			continue
+		}
+		v, method, _ := e.iterVariant()
+		elem := strings.TrimPrefix(e.Result, "[]")
+		fmt.Fprintf(b, "\n// Iter%s returns an iterator over the results of %s,\n// following every page.\n", e.listName(), method)
+		fmt.Fprintf(b, "func (c *Client) Iter%s(ctx context.Context, %s) iter.Seq2[%s, error] {\n", e.listName(), v.params(), elem)
+		fmt.Fprintf(b, "\treturn iterate(ctx, c.Prefetch, func(ctx context.Context) (%s, *NextRequest, error) {\n", e.Result)
+		fmt.Fprintf(b, "\t\treturn c.%sContext(ctx, %s)\n\t})\n}\n", method, v.args())
+	}
+	for i := range endpoints {
+		e := &endpoints[i]
+		if !e.paged() {
+			continue
+		}
+		v, method, path := e.iterVariant()
+		elem := strings.TrimPrefix(e.Result, "[]")
+		v = v.add("fn", fmt.Sprintf("func(%s) error", elem))
+		fmt.Fprintf(b, "\n// Stream%s calls fn with every result of %s, following every page.\n", e.listName(), method)
+		fmt.Fprintf(b, "func (c *Client) Stream%s(ctx context.Context, %s) error {\n", e.listName(), v.params())
+		fmt.Fprintf(b, "\treturn stream(ctx, c, %q, %s, token, fn)\n}\n", e.op(), path)
+	}
+}
+
+// writeNote writes a paragraph of a doc comment, if note isn't empty.
+func writeNote(b *bytes.Buffer, note string) {
+	if len(note) > 0 {
+		fmt.Fprintf(b, "//\n// %s\n", note)
+	}
+}
+
+// wrapper writes the package-level wrapper of a Client method, with the
+// deprecation notice note, if any.
+func wrapper(b *bytes.Buffer, name, params, args, results, note string) {
+	fmt.Fprintf(b, "\n// %s calls %s on DefaultClient.\n", name, name)
+	writeNote(b, note)
+	fmt.Fprintf(b, "func %s(%s) %s {\n\treturn DefaultClient.%s(%s)\n}\n", name, params, results, name, args)
+}
+
+func genDefaults(b *bytes.Buffer) {
+	b.WriteString("package gosquare\n\nimport (\n\t\"context\"\n\t\"iter\"\n)\n")
+	for i := range endpoints {
+		e := &endpoints[i]
+		v := e.positional()
+		ctxParams := "ctx context.Context, " + v.params()
+		ctxArgs := "ctx, " + v.args()
+		wrapper(b, e.Name, v.params(), v.args(), e.results(), e.deprecated(""))
+		wrapper(b, e.Name+"Context", ctxParams, ctxArgs, e.results(), e.deprecated("Context"))
+		if len(e.Options) == 0 {
+			continue
+		}
+		o := e.withOptions()
+		wrapper(b, e.Name+"WithOptions", o.params(), o.args(), e.results(), "")
+		wrapper(b, e.Name+"WithOptionsContext", "ctx context.Context, "+o.params(), "ctx, "+o.args(), e.results(), "")
+	}
+	for i := range endpoints {
+		e := &endpoints[i]
+		v := e.positional()
+		wrapper(b, e.Name+"BatchRequest", v.params(), v.args(), "(*BatchRequest, string)", e.deprecated("BatchRequest"))
+		if len(e.Options) == 0 {
+			wrapper(b, e.Name+"BatchCall", v.params(), v.args(), fmt.Sprintf("*BatchCall[%s]", e.callType()), e.deprecated("BatchCall"))
+			continue
+		}
+		o := e.withOptions()
+		wrapper(b, e.Name+"WithOptionsBatchRequest", o.params(), o.args(), "(*BatchRequest, string)", "")
+		wrapper(b, e.Name+"WithOptionsBatchCall", o.params(), o.args(), fmt.Sprintf("*BatchCall[%s]", e.callType()), "")
+	}
+	for i := range endpoints {
+		e := &endpoints[i]
+		if !e.paged() {
+			continue
+		}
+		v, _, _ := e.iterVariant()
+		elem := strings.TrimPrefix(e.Result, "[]")
+		wrapper(b, "Iter"+e.listName(), "ctx context.Context, "+v.params(), "ctx, "+v.args(), fmt.Sprintf("iter.Seq2[%s, error]", elem), "")
+	}
+	for i := range endpoints {
+		e := &endpoints[i]
+		if !e.paged() {
+			continue
+		}
+		v, _, _ := e.iterVariant()
+		v = v.add("fn", fmt.Sprintf("func(%s) error", strings.TrimPrefix(e.Result, "[]")))
+		wrapper(b, "Stream"+e.listName(), "ctx context.Context, "+v.params(), "ctx, "+v.args(), "error", "")
+	}
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
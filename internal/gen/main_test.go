@@ -0,0 +1,26 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedFilesAreCurrent fails if a generated file of the gosquare
+// directory doesn't match the endpoint table, run go generate to fix it.
+func TestGeneratedFilesAreCurrent(t *testing.T) {
+	for _, out := range outputs {
+		want, err := render(out.gen)
+		if err != nil {
+			t.Fatalf("formatting %s: %v", out.name, err)
+		}
+		got, err := os.ReadFile(filepath.Join("..", "..", out.name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is not up to date, run go generate", out.name)
+		}
+	}
+}
@@ -0,0 +1,1160 @@
+package main
+
+// endpoints are the endpoints of the Connect v1 API, in the order their
+// methods appear in the generated files. UploadItemImage, which sends a
+// multipart body, and SubmitBatch are written by hand.
+var endpoints = []endpoint{
+	{
+		Name: "RetrieveBusiness",
+		Doc: []string{
+			"Provides a business's account information, such as its name and associated email address.",
+		},
+		Permissions: []string{"MERCHANT_PROFILE_READ"},
+		Method:      "GET",
+		Path:        "/v1/me",
+		Result:      "*Merchant",
+	},
+	{
+		Name: "ListLocations",
+		Doc: []string{
+			"Provides details for a business's locations, including their IDs.",
+			"",
+			"The account_capabilities array returned in each Merchant object indicates which",
+			"account capabilities the location has enabled. For example, if this array does not include the",
+			"value CREDIT_CARD_PROCESSING, the location cannot currently process credit cards with",
+			"Square.",
+		},
+		Permissions: []string{"MERCHANT_PROFILE_READ"},
+		Method:      "GET",
+		Path:        "/v1/me/locations",
+		Result:      "[]*Merchant",
+	},
+	{
+		Name: "CreateEmployee",
+		Doc: []string{
+			"Creates an employee for a business.",
+		},
+		Permissions: []string{"EMPLOYEES_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/me/employees",
+		Body:        "*CreateEmployeeReqObject",
+		Result:      "*Employee",
+	},
+	{
+		Name: "ListEmployees",
+		Doc: []string{
+			"Provides summary information for all of a business's employees.",
+		},
+		Permissions: []string{"EMPLOYEES_READ"},
+		ParamDoc: []string{
+			"You can filter the results returned by this endpoint by exactly one of the following",
+			"fields:",
+			"",
+			"`order`:",
+			"The order in which employees are listed in the response, based on their",
+			"created_at field.Default value: ASC",
+			"",
+			"`beginUpdatedAt`:",
+			"If filtering results by their updated_at field, the beginning of the requested",
+			"reporting period, in ISO",
+			"8601 format.",
+			"",
+			"`endUpdatedAt`:",
+			"If filtering results by there updated_at field, the end of the requested",
+			"reporting period, in ISO",
+			"8601 format.",
+			"",
+			"`beginCreatedAt`:",
+			"If filtering results by their created_at field, the beginning of the requested",
+			"reporting period, in ISO",
+			"8601 format.",
+			"",
+			"`endCreatedAt`:",
+			"If filtering results by their created_at field, the end of the requested",
+			"reporting period, in ISO",
+			"8601 format.",
+			"",
+			"`status`:",
+			"If provided, the endpoint returns only employee entities with the specified",
+			"status (ACTIVE or INACTIVE).",
+			"",
+			"`externalID`:",
+			"If provided, the endpoint returns only employee entities with the specified",
+			"external_id.",
+			"",
+			"`limit`:",
+			"The maximum number of employee entities to return in a single response. This value",
+			"cannot exceed 200.This value is always an integer.Default value: 100",
+		},
+		Method: "GET",
+		Path:   "/v1/me/employees",
+		Query: []param{
+			{Name: "order", Type: "string"},
+			{Name: "beginUpdatedAt", Type: "string"},
+			{Name: "endUpdatedAt", Type: "string"},
+			{Name: "beginCreatedAt", Type: "string"},
+			{Name: "endCreatedAt", Type: "string"},
+			{Name: "status", Type: "string"},
+			{Name: "externalID", Type: "string"},
+			{Name: "limit", Type: "int"},
+		},
+		Options: "ListEmployeesOptions",
+		Result:  "[]*Employee",
+	},
+	{
+		Name: "RetrieveEmployee",
+		Doc: []string{
+			"Provides the details for a single employee.",
+		},
+		Permissions: []string{"EMPLOYEES_READ"},
+		Method:      "GET",
+		Path:        "/v1/me/employees/{employeeID}",
+		Result:      "*Employee",
+	},
+	{
+		Name: "UpdateEmployee",
+		Doc: []string{
+			"Modifies the details of an employee.",
+		},
+		Permissions: []string{"EMPLOYEES_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/me/employees/{employeeID}",
+		Body:        "*UpdateEmployeeReqObject",
+		Result:      "*Employee",
+	},
+	{
+		Name: "CreateRole",
+		Doc: []string{
+			"Creates an employee role you can then assign to employees.",
+		},
+		Permissions: []string{"EMPLOYEES_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/me/roles",
+		Body:        "*CreateRoleReqObject",
+		Result:      "*EmployeeRole",
+	},
+	{
+		Name: "ListRoles",
+		Doc: []string{
+			"Provides summary information for all of a business's employee roles.",
+		},
+		Permissions: []string{"EMPLOYEES_READ"},
+		ParamDoc: []string{
+			"`order`:",
+			"The order in which roles are listed in the response.Default value: ASC",
+			"",
+			"`limit`:",
+			"The maximum number of employee entities to return in a single response. This value",
+			"cannot exceed 200.This value is always an integer.Default value: 100",
+		},
+		Method: "GET",
+		Path:   "/v1/me/roles",
+		Query: []param{
+			{Name: "order", Type: "string"},
+			{Name: "limit", Type: "int"},
+		},
+		Options: "ListRolesOptions",
+		Result:  "[]*EmployeeRole",
+	},
+	{
+		Name: "RetrieveRole",
+		Doc: []string{
+			"Provides the details for a single employee role.",
+		},
+		Permissions: []string{"EMPLOYEES_READ"},
+		Method:      "GET",
+		Path:        "/v1/me/roles/{roleID}",
+		Result:      "*EmployeeRole",
+	},
+	{
+		Name: "UpdateRole",
+		Doc: []string{
+			"Modifies the details of an employee role.",
+		},
+		Permissions: []string{"EMPLOYEES_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/me/roles/{roleID}",
+		Body:        "*UpdateRoleReqObject",
+		Result:      "*EmployeeRole",
+	},
+	{
+		Name: "CreateTimecard",
+		Doc: []string{
+			"Creates a timecard for an employee. Each timecard corresponds to a single shift.",
+			"",
+			"This endpoint automatically creates an API_CREATE event for the new timecard.",
+		},
+		Permissions: []string{"TIMECARDS_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/me/timecards",
+		Body:        "*CreateTimecardReqObject",
+		Result:      "*Timecard",
+	},
+	{
+		Name: "ListTimecards",
+		Doc: []string{
+			"Provides summary information for all of a business's employee timecards.",
+		},
+		Permissions: []string{"TIMECARDS_READ"},
+		ParamDoc: []string{
+			"You can filter the results returned by this endpoint by exactly one of the following",
+			"fields:",
+			"",
+			"`order`:",
+			"The order in which timecards are listed in the response, based on their",
+			"created_at field.Default value: ASC",
+			"",
+			"`employeeID`:",
+			"If provided, the endpoint returns only timecards for the employee with the specified",
+			"ID.",
+			"",
+			"`beginClockinTime`:",
+			"If filtering results by their clockin_time field, the beginning of the requested",
+			"reporting period, in ISO",
+			"8601 format.",
+			"",
+			"`endClockinTime`:",
+			"If filtering results by their clockin_time field, the end of the requested",
+			"reporting period, in ISO",
+			"8601 format.",
+			"",
+			"`beginClockoutTime`:",
+			"If filtering results by their clockout_time field, the beginning of the",
+			"requested reporting period, in ISO 8601 format.",
+			"",
+			"`endClockoutTime`:",
+			"If filtering results by their clockout_time field, the end of the requested",
+			"reporting period, in ISO",
+			"8601 format.",
+			"",
+			"`beginUpdatedAt`:",
+			"If filtering results by their updated_at field, the beginning of the requested",
+			"reporting period, in ISO",
+			"8601 format.",
+			"",
+			"`endUpdatedAt`:",
+			"If filtering results by their updated_at field, the end of the requested",
+			"reporting period, in ISO",
+			"8601 format.",
+			"",
+			"`deleted`:",
+			"If true, only deleted timecards are returned. If false, only valid",
+			"timecards are returned.If you don't provide this parameter, both valid and deleted timecards are returned.",
+			"",
+			"`limit`:",
+			"The maximum number of timecards to return in a single response. This value cannot",
+			"exceed 200.This value is always an integer.",
+		},
+		Method: "GET",
+		Path:   "/v1/me/timecards",
+		Query: []param{
+			{Name: "order", Type: "string"},
+			{Name: "employeeID", Type: "string"},
+			{Name: "beginClockinTime", Type: "string"},
+			{Name: "endClockinTime", Type: "string"},
+			{Name: "beginClockoutTime", Type: "string"},
+			{Name: "endClockoutTime", Type: "string"},
+			{Name: "beginUpdatedAt", Type: "string"},
+			{Name: "endUpdatedAt", Type: "string"},
+			{Name: "deleted", Type: "bool", Ref: true},
+			{Name: "limit", Type: "int"},
+		},
+		Options: "ListTimecardsOptions",
+		Result:  "[]*Timecard",
+	},
+	{
+		Name: "RetrieveTimecard",
+		Doc: []string{
+			"Currently, only approved merchants can manage their employees with Square. Unapproved merchants cannot use employee management features you include in your application.",
+			"Provides the details for a single timecard.",
+		},
+		Permissions: []string{"TIMECARDS_READ"},
+		Method:      "GET",
+		Path:        "/v1/me/timecards/{timecardID}",
+		Result:      "*Timecard",
+	},
+	{
+		Name: "UpdateTimecard",
+		Doc: []string{
+			"Modifies a timecard's details. This creates an API_EDIT event for the timecard. You",
+			"can view a timecard's event history with the List Timecard",
+			"Events endpoint.",
+		},
+		Permissions: []string{"TIMECARDS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/me/timecards/{timecardID}",
+		Body:        "*UpdateTimecardReqObject",
+		Result:      "*Timecard",
+	},
+	{
+		Name: "DeleteTimecard",
+		Doc: []string{
+			"Deletes a timecard. Deleted timecards are still accessible from Connect API endpoints, but",
+			"the value of their deleted field is set to true.",
+		},
+		Permissions: []string{"TIMECARDS_WRITE"},
+		Method:      "DELETE",
+		Path:        "/v1/me/timecards/{timecardID}",
+	},
+	{
+		Name: "ListTimecardEvents",
+		Doc: []string{
+			"Provides summary information for all events associated with a particular timecard.",
+		},
+		Permissions: []string{"TIMECARDS_READ"},
+		Method:      "GET",
+		Path:        "/v1/me/timecards/{timecardID}/events",
+		Result:      "[]*TimecardEvent",
+	},
+	{
+		Name: "ListCashDrawerShifts",
+		Doc: []string{
+			"Provides the details for all of a location's cash drawer shifts during a date range. The date",
+			"range you specify cannot exceed 90 days.",
+			"",
+			"CashDrawerShift objects returned by this endpoint do not include the",
+			"events field, which lists the events that occurred during the shift. To get a",
+			"particular shift's events, use the Retrieve Cash Drawer",
+			"Shift endpoint.",
+		},
+		Permissions: []string{"PAYMENTS_READ"},
+		ParamDoc: []string{
+			"`beginTime`:",
+			"The beginning of the requested reporting period, in ISO 8601 format.Default value: The current time minus 90 days.",
+			"",
+			"`endTime`:",
+			"The beginning of the requested reporting period, in ISO 8601 format.Default value: The current time.",
+			"",
+			"`order`:",
+			"The order in which cash drawer shifts are listed in the response, based on their",
+			"created_at field.Default value: ASC",
+		},
+		Method: "GET",
+		Path:   "/v1/{locationID}/cash-drawer-shifts",
+		Query: []param{
+			{Name: "beginTime", Type: "string"},
+			{Name: "endTime", Type: "string"},
+			{Name: "order", Type: "string"},
+		},
+		Options: "ListCashDrawerShiftsOptions",
+		Result:  "[]*CashDrawerShift",
+	},
+	{
+		Name: "RetrieveCashDrawerShift",
+		Doc: []string{
+			"Provides the details for a single cash drawer shift, including all events that occurred",
+			"during the shift.",
+		},
+		Permissions: []string{"PAYMENTS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/cash-drawer-shifts/{shiftID}",
+		Result:      "*CashDrawerShift",
+	},
+	{
+		Name: "ListPayments",
+		Doc: []string{
+			"Provides summary information for all payments taken by a merchant or any of the merchant's",
+			"mobile staff during a date range.",
+			"Date ranges cannot exceed one year in length. See Date ranges",
+			"for details of inclusive and exclusive dates.",
+		},
+		Permissions: []string{"PAYMENTS_READ"},
+		ParamDoc: []string{
+			"`beginTime`:",
+			"The beginning of the requested reporting period, in ISO 8601 format.If this value is before January 1, 2013 (2013-01-01T00:00:00Z), this endpoint",
+			"returns an error.Default value: The current time minus one year.",
+			"",
+			"`endTime`:",
+			"The end of the requested reporting period, in ISO 8601 format.If this value is more than one year greater than begin_time, this endpoint",
+			"returns an error.Default value: The current time.",
+			"",
+			"`order`:",
+			"The order in which payments are listed in the response.Default value: ASC",
+			"",
+			"`limit`:",
+			"The maximum number of payments to return in a single response. This value cannot exceed",
+			"200.This value is always an integer.Default value: 100",
+		},
+		Method: "GET",
+		Path:   "/v1/{locationID}/payments",
+		Query: []param{
+			{Name: "beginTime", Type: "string"},
+			{Name: "endTime", Type: "string"},
+			{Name: "order", Type: "string"},
+			{Name: "limit", Type: "int"},
+		},
+		Options: "ListPaymentsOptions",
+		Result:  "[]*Payment",
+	},
+	{
+		Name: "RetrievePayment",
+		Doc: []string{
+			"Provides comprehensive information for a single payment.",
+		},
+		Permissions: []string{"PAYMENTS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/payments/{paymentID}",
+		Result:      "*Payment",
+	},
+	{
+		Name: "ListSettlements",
+		Doc: []string{
+			"Provides summary information for all deposits and withdrawals initiated by Square to a",
+			"merchant's bank account during a date range. Date ranges cannot exceed one year in length. See",
+			"Date ranges for details of inclusive and exclusive",
+			"dates.",
+			"",
+			"Settlement objects returned by this endpoint do not include the entries",
+			"field, which lists the transactions that contribute to the total of the settlement. To get a",
+			"particular settlement's entries, use the Retrieve",
+			"Settlement endpoint.",
+			"",
+			"Square initiates its regular deposits to merchant bank accounts on the schedule indicated on",
+			"this page. A deposit initiated by",
+			"Square on a given day is usually not provided by this endpoint before 10 p.m. PST the same",
+			"day.",
+			"",
+			"Square does not know when an initiated settlement completes, only whether it has",
+			"failed. A completed settlement is typically reflected in a merchant's bank account",
+			"within three business days, but in exceptional cases it might take longer.",
+		},
+		Permissions: []string{"SETTLEMENTS_READ"},
+		ParamDoc: []string{
+			"`beginTime`:",
+			"The beginning of the requested reporting period, in ISO 8601 format.If this value is before January 1, 2013 (2013-01-01T00:00:00Z), this endpoint",
+			"returns an error.Default value: The current time minus one year.",
+			"",
+			"`endTime`:",
+			"The end of the requested reporting period, in ISO 8601 format.If this value is more than one year greater than begin_time, this endpoint",
+			"returns an error.Default value: The current time.",
+			"",
+			"`order`:",
+			"The order in which settlements are listed in the response.Default value: ASC",
+			"",
+			"`limit`:",
+			"The maximum number of settlements to return in a single response. This value cannot",
+			"exceed 200.This value is always an integer.Default value: 100",
+			"",
+			"`status`:",
+			"Provide this parameter to retrieve only settlements with a particular status",
+			"(SENT or FAILED).",
+		},
+		Method: "GET",
+		Path:   "/v1/{locationID}/settlements",
+		Query: []param{
+			{Name: "beginTime", Type: "string"},
+			{Name: "endTime", Type: "string"},
+			{Name: "order", Type: "string"},
+			{Name: "limit", Type: "int"},
+			{Name: "status", Type: "string"},
+		},
+		Options: "ListSettlementsOptions",
+		Result:  "[]*Settlement",
+	},
+	{
+		Name: "RetrieveSettlement",
+		Doc: []string{
+			"Provides comprehensive information for a single settlement, including the entries that",
+			"contribute to the settlement's total.",
+			"",
+			"See SettlementEntry.Type for",
+			"descriptions of the types of entries that compose a settlement.",
+		},
+		Permissions: []string{"SETTLEMENTS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/settlements/{settlementID}",
+		Result:      "*Settlement",
+	},
+	{
+		Name: "CreateRefund",
+		Doc: []string{
+			"Issues a refund for a previously processed payment. You must issue a refund within 60 days of",
+			"the associated payment. See this",
+			"article for more information on refund behavior.",
+			"",
+			"Issuing a refund for a card payment is not reversible. To develop against this",
+			"endpoint, you can create fake cash payments in Square Register and refund them.",
+			"",
+			"You can issue either full refunds or partial refunds. If you issue a partial refund, you must",
+			"specify the amount of money to refund.",
+		},
+		Permissions: []string{"PAYMENTS_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/{locationID}/refunds",
+		Body:        "*CreateRefundReqObject",
+		Result:      "*Refund",
+	},
+	{
+		Name: "ListRefunds",
+		Doc: []string{
+			"Provides the details for all refunds initiated by a merchant or any of the merchant's mobile staff during a date range. Date",
+			"ranges cannot exceed one year in length. See Date ranges for",
+			"details of inclusive and exclusive dates.",
+		},
+		Permissions: []string{"PAYMENTS_READ"},
+		ParamDoc: []string{
+			"`beginTime`:",
+			"The beginning of the requested reporting period, in ISO 8601 format.If this value is before January 1, 2013 (2013-01-01T00:00:00Z), this endpoint",
+			"returns an error.Default value: The current time minus one year.",
+			"",
+			"`endTime`:",
+			"The end of the requested reporting period, in ISO 8601 format.If this value is more than one year greater than begin_time, this endpoint",
+			"returns an error.Default value: The current time.",
+			"",
+			"`order`:",
+			"The order in which refunds are listed in the response.Default value: ASC",
+			"",
+			"`limit`:",
+			"The maximum number of refunds to return in a single response. This value cannot exceed",
+			"200.This value is always an integer.Default value: 100",
+		},
+		Method: "GET",
+		Path:   "/v1/{locationID}/refunds",
+		Query: []param{
+			{Name: "beginTime", Type: "string"},
+			{Name: "endTime", Type: "string"},
+			{Name: "order", Type: "string"},
+			{Name: "limit", Type: "int"},
+		},
+		Options: "ListRefundsOptions",
+		Result:  "[]*Refund",
+	},
+	{
+		Name: "ListOrders",
+		Doc: []string{
+			"Provides summary information for a merchant's online store orders.",
+		},
+		Permissions: []string{"ORDERS_READ"},
+		ParamDoc: []string{
+			"`limit`:",
+			"The maximum number of orders to return in a single response. This value cannot exceed",
+			"200.This value is always an integer.Default value: 100",
+			"",
+			"`order`:",
+			"Indicates whether orders are listed in chronological (ASC) or",
+			"reverse-chronological (DESC) order.Default value: ASC",
+		},
+		Method: "GET",
+		Path:   "/v1/{locationID}/orders",
+		Query: []param{
+			{Name: "limit", Type: "int"},
+			{Name: "order", Type: "string"},
+		},
+		Options: "ListOrdersOptions",
+		Result:  "[]*Order",
+	},
+	{
+		Name: "RetrieveOrder",
+		Doc: []string{
+			"Provides comprehensive information for a single online store order, including the order's",
+			"history.",
+		},
+		Permissions: []string{"ORDERS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/orders/{orderID}",
+		Result:      "*Order",
+	},
+	{
+		Name: "UpdateOrder",
+		Doc: []string{
+			"Updates the details of an online store order. Every update you perform on an order",
+			"corresponds to one of three actions: COMPLETE, CANCEL or REFUND.",
+		},
+		Permissions: []string{"ORDERS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/orders/{orderID}",
+		Body:        "*UpdateOrderReqObject",
+		Result:      "*Order",
+	},
+	{
+		Name: "ListBankAccounts",
+		Doc: []string{
+			"Provides non-confidential details for all of a location's associated bank accounts. This",
+			"endpoint does not provide full bank account numbers, and there is no way to obtain a",
+			"full bank account number with the Connect API.",
+		},
+		Permissions: []string{"BANK_ACCOUNTS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/bank-accounts",
+		Result:      "[]*BankAccount",
+	},
+	{
+		Name: "RetrieveBankAccount",
+		Doc: []string{
+			"Provides non-confidential details for a merchant's associated bank account. This endpoint",
+			"does not provide full bank account numbers, and there is no way to obtain a full bank",
+			"account number with the Connect API.",
+		},
+		Permissions: []string{"BANK_ACCOUNTS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/bank-accounts/{bankAccountID}",
+		Result:      "*BankAccount",
+	},
+	{
+		Name: "CreateItem",
+		Doc: []string{
+			"Creates an item and at least one variation for it.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/{locationID}/items",
+		Body:        "*CreateItemReqObject",
+		Result:      "*Item",
+	},
+	{
+		Name: "ListItems",
+		Doc: []string{
+			"Provides summary information for all of a location's items.",
+		},
+		Permissions: []string{"ITEMS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/items",
+		Result:      "[]*Item",
+	},
+	{
+		Name: "RetrieveItem",
+		Doc: []string{
+			"Provides the details for a single item, including associated modifier lists and fees.",
+		},
+		Permissions: []string{"ITEMS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/items/{itemID}",
+		Result:      "*Item",
+	},
+	{
+		Name: "UpdateItem",
+		Doc: []string{
+			"Modifies the core details of an existing item.",
+			"",
+			"If you want to modify an item's variations, use the Update Variation endpoint instead.",
+			"",
+			"If you want to add or remove a modifier list from an item, use the Apply Modifier List and Remove Modifier List endpoints instead.",
+			"",
+			"If you want to add or remove a fee from an item, use the Apply Fee and Remove Fee endpoints",
+			"instead.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/items/{itemID}",
+		Body:        "*UpdateItemReqObject",
+		Result:      "*Item",
+	},
+	{
+		Name: "DeleteItem",
+		Doc: []string{
+			"Deletes an existing item and all item variations associated with it.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "DELETE",
+		Path:        "/v1/{locationID}/items/{itemID}",
+	},
+	{
+		Name: "CreateVariation",
+		Doc: []string{
+			"Creates an item variation for an existing item.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/{locationID}/items/{itemID}/variations",
+		Body:        "*CreateVariationReqObject",
+		Result:      "*ItemVariation",
+	},
+	{
+		Name: "UpdateVariation",
+		Doc: []string{
+			"Modifies the details of an existing item variation.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/items/{itemID}/variations/{variationID}",
+		Body:        "*UpdateVariationReqObject",
+		Result:      "*ItemVariation",
+	},
+	{
+		Name: "DeleteVariation",
+		Doc: []string{
+			"Deletes an existing item variation from an item.",
+			"",
+			"Every item must have at least one varation. This endpoint returns an error if you attempt to",
+			"delete an item's only variation.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "DELETE",
+		Path:        "/v1/{locationID}/items/{itemID}/variations/{variationID}",
+	},
+	{
+		Name: "ListInventory",
+		Doc: []string{
+			"Provides inventory information for all of a merchant's inventory-enabled item variations.",
+			"",
+			"See Managing inventory to learn how to enable an item",
+			"variation for inventory tracking.",
+		},
+		Permissions: []string{"ITEMS_READ"},
+		ParamDoc: []string{
+			"`limit`:",
+			"The maximum number of inventory entries to return in a single response. This value",
+			"cannot exceed 1000.This value is always an integer.Default value: 1000",
+		},
+		Method: "GET",
+		Path:   "/v1/{locationID}/inventory",
+		Query: []param{
+			{Name: "limit", Type: "int"},
+		},
+		Result: "[]*InventoryEntry",
+	},
+	{
+		Name: "AdjustInventory",
+		Doc: []string{
+			"Adjusts an item variation's current available inventory.",
+			"",
+			"See Managing inventory to learn how to enable an item",
+			"variation for inventory tracking.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/{locationID}/inventory/{variationID}",
+		Body:        "*AdjustInventoryReqObject",
+		Result:      "*InventoryEntry",
+	},
+	{
+		Name: "CreateModifierList",
+		Doc: []string{
+			"Creates an item modifier list and at least one modifier option for it.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/{locationID}/modifier-lists",
+		Body:        "*CreateModifierListReqObject",
+		Result:      "*ModifierList",
+	},
+	{
+		Name: "ListModifierLists",
+		Doc: []string{
+			"Lists all of a location's modifier lists.",
+		},
+		Permissions: []string{"ITEMS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/modifier-lists",
+		Result:      "[]*ModifierList",
+	},
+	{
+		Name: "RetrieveModifierList",
+		Doc: []string{
+			"Provides the details for a single modifier list.",
+		},
+		Permissions: []string{"ITEMS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/modifier-lists/{modifierListID}",
+		Result:      "*ModifierList",
+	},
+	{
+		Name: "UpdateModifierList",
+		Doc: []string{
+			"Modifies the details of an existing item modifier list.",
+			"",
+			"If you want to modify the details of a single modifier option, use the Update Modifier Option endpoint instead.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/modifier-lists/{modifierListID}",
+		Body:        "*UpdateModifierListReqObject",
+		Result:      "*ModifierList",
+	},
+	{
+		Name: "DeleteModifierList",
+		Doc: []string{
+			"Deletes an existing item modifier list and all modifier options associated with it.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "DELETE",
+		Path:        "/v1/{locationID}/modifier-lists/{modifierListID}",
+	},
+	{
+		Name: "ApplyModifierList",
+		Doc: []string{
+			"Associates a modifier list with an item, meaning modifier options from the list can be",
+			"applied to the item.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/items/{itemID}/modifier-lists/{modifierListID}",
+		Result:      "*Item",
+	},
+	{
+		Name: "RemoveModifierList",
+		Doc: []string{
+			"Removes a modifier list association from an item, meaning modifier options from the list can",
+			"no longer be applied to the item.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "DELETE",
+		Path:        "/v1/{locationID}/items/{itemID}/modifier-lists/{modifierListID}",
+	},
+	{
+		Name: "CreateModifierOption",
+		Doc: []string{
+			"Creates an item modifier option and adds it to a modifier list.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/{locationID}/modifier-lists/{modifierListID}/modifier-options",
+		Body:        "*CreateModifierOptionReqObject",
+		Result:      "*ModifierOption",
+	},
+	{
+		Name: "UpdateModifierOption",
+		Doc: []string{
+			"Modifies the details of an existing item modifier option.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/modifier-lists/{modifierListID}/modifier-options/{modifierOptionID}",
+		Body:        "*UpdateModifierOptionReqObject",
+		Result:      "*ModifierOption",
+	},
+	{
+		Name: "DeleteModifierOption",
+		Doc: []string{
+			"Deletes an existing item modifier option from a modifier list.",
+			"",
+			"Every modifier list must have at least one option. This endpoint returns an error if you",
+			"attempt to delete a modifier list's only option.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "DELETE",
+		Path:        "/v1/{locationID}/modifier-lists/{modifierListID}/modifier-options/{modifierOptionID}",
+	},
+	{
+		Name: "CreateCategory",
+		Doc: []string{
+			"Creates an item category.",
+			"",
+			"To add or remove an item from a category, use the Update",
+			"Item endpoint.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/{locationID}/categories",
+		Body:        "*CreateCategoryReqObject",
+		Result:      "*Category",
+	},
+	{
+		Name: "ListCategories",
+		Doc: []string{
+			"Lists all of a location's item categories.",
+		},
+		Permissions: []string{"ITEMS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/categories",
+		Result:      "[]*Category",
+	},
+	{
+		Name: "UpdateCategory",
+		Doc: []string{
+			"Modifies the details of an existing item category.",
+			"",
+			"To add or remove an item from a category, use the Update",
+			"Item endpoint.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/categories/{categoryID}",
+		Body:        "*UpdateCategoryReqObject",
+		Result:      "*Category",
+	},
+	{
+		Name: "DeleteCategory",
+		Doc: []string{
+			"Deletes an existing item category.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "DELETE",
+		Path:        "/v1/{locationID}/categories/{categoryID}",
+	},
+	{
+		Name: "CreateDiscount",
+		Doc: []string{
+			"Creates a discount.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/{locationID}/discounts",
+		Body:        "*CreateDiscountReqObject",
+		Result:      "*Discount",
+	},
+	{
+		Name: "ListDiscounts",
+		Doc: []string{
+			"Lists all of a location's discounts.",
+		},
+		Permissions: []string{"ITEMS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/discounts",
+		Result:      "[]*Discount",
+	},
+	{
+		Name: "UpdateDiscount",
+		Doc: []string{
+			"Modifies the details of an existing discount.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/discounts/{discountID}",
+		Body:        "*UpdateDiscountReqObject",
+		Result:      "*Discount",
+	},
+	{
+		Name: "DeleteDiscount",
+		Doc: []string{
+			"Deletes an existing discount.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "DELETE",
+		Path:        "/v1/{locationID}/discounts/{discountID}",
+	},
+	{
+		Name: "CreateFee",
+		Doc: []string{
+			"Creates a fee (tax).",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/{locationID}/fees",
+		Body:        "*CreateFeeReqObject",
+		Result:      "*Fee",
+	},
+	{
+		Name: "ListFees",
+		Doc: []string{
+			"Lists all of a location's fees (taxes).",
+		},
+		Permissions: []string{"ITEMS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/fees",
+		Result:      "[]*Fee",
+	},
+	{
+		Name: "UpdateFee",
+		Doc: []string{
+			"Modifies the details of an existing fee (tax).",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/fees/{feeID}",
+		Body:        "*UpdateFeeReqObject",
+		Result:      "*Fee",
+	},
+	{
+		Name: "DeleteFee",
+		Doc: []string{
+			"Deletes an existing fee (tax).",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "DELETE",
+		Path:        "/v1/{locationID}/fees/{feeID}",
+	},
+	{
+		Name: "ApplyFee",
+		Doc: []string{
+			"Associates a fee with an item, meaning the fee is automatically applied to the item in Square",
+			"Register.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/items/{itemID}/fees/{feeID}",
+		Result:      "*Item",
+	},
+	{
+		Name: "RemoveFee",
+		Doc: []string{
+			"Removes a fee assocation from an item, meaning the fee is no longer automatically applied to",
+			"the item in Square Register.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "DELETE",
+		Path:        "/v1/{locationID}/items/{itemID}/fees/{feeID}",
+	},
+	{
+		Name: "CreatePage",
+		Doc: []string{
+			"Creates a Favorites page in Square Register.",
+			"",
+			"A merchant can have up to five pages, each of which has a page_index between 0",
+			"and 4, inclusive.",
+			"",
+			"After you create a page, you can set the values of its cells with the Update Cell endpoint. A page doesn't appear in Square Register",
+			"unless at least one of its cells has an assigned value.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "POST",
+		Path:        "/v1/{locationID}/pages",
+		Body:        "*CreatePageReqObject",
+		Result:      "*Page",
+	},
+	{
+		Name: "ListPages",
+		Doc: []string{
+			"Lists all of a location's Favorites pages in Square Register.",
+		},
+		Permissions: []string{"ITEMS_READ"},
+		Method:      "GET",
+		Path:        "/v1/{locationID}/pages",
+		Result:      "[]*Page",
+	},
+	{
+		Name: "UpdatePage",
+		Doc: []string{
+			"Modifies the details of a Favorites page in Square Register.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/pages/{pageID}",
+		Body:        "*UpdatePageReqObject",
+		Result:      "*Page",
+	},
+	{
+		Name: "DeletePage",
+		Doc: []string{
+			"Deletes an existing Favorites page and all of its cells.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "DELETE",
+		Path:        "/v1/{locationID}/pages/{pageID}",
+	},
+	{
+		Name: "UpdateCell",
+		Doc: []string{
+			"Modifies a cell of a Favorites page in Square Register.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/pages/{pageID}/cells",
+		Body:        "*UpdateCellReqObject",
+		Result:      "*PageCell",
+	},
+	{
+		Name: "DeleteCell",
+		Doc: []string{
+			"Deletes a cell from a Favorites page in Square Register.",
+		},
+		Permissions: []string{"ITEMS_WRITE"},
+		ParamDoc: []string{
+			"`row`:",
+			"The row of the cell to clear. Always an integer between 0 and 4,",
+			"inclusive. Row 0 is the top row.",
+			"",
+			"`column`:",
+			"The column of the cell to clear. Always an integer between 0 and 4,",
+			"inclusive. Column 0 is the leftmost column.",
+		},
+		Method: "DELETE",
+		Path:   "/v1/{locationID}/pages/{pageID}/cells",
+		Query: []param{
+			{Name: "row", Type: "int"},
+			{Name: "column", Type: "int"},
+		},
+	},
+	{
+		Name: "ListWebhooks",
+		Doc: []string{
+			"Lists which types of events trigger webhook notifications for a particular location.",
+		},
+		Method: "GET",
+		Path:   "/v1/{locationID}/webhooks",
+		Result: "[]string",
+	},
+	{
+		Name: "UpdateWebhooks",
+		Doc: []string{
+			"Sets which types of events trigger webhook notifications for a location.",
+			"",
+			"It sends no event types, and its NextRequest is always nil.",
+		},
+		Deprecated:  "UpdateWebhooksWithEventTypes",
+		Method:      "PUT",
+		Path:        "/v1/{locationID}/webhooks",
+		Result:      "[]string",
+		NextRequest: true,
+	},
+	{
+		Name:      "UpdateWebhooksWithEventTypes",
+		Operation: "UpdateWebhooks",
+		Doc: []string{
+			"Sets which types of events trigger webhook notifications for a location, and returns",
+			"them.",
+			"",
+			"Simply provide the event types you want notifications for as eventTypes.",
+		},
+		Method:   "PUT",
+		Path:     "/v1/{locationID}/webhooks",
+		Body:     "[]string",
+		BodyName: "eventTypes",
+		Result:   "[]string",
+	},
+	{
+		Name: "ListSubscriptions",
+		Doc: []string{
+			"Lists subscriptions that have been created for an application. You can look up subscription",
+			"information for a single merchant by providing the merchant_id parameter to this",
+			"endpoint.",
+			"",
+			"Subscription objects returned by this endpoint do not include the fees",
+			"field, which lists the subscription's payment history. To get a particular subscription's",
+			"payment history, use the Retrieve Subscription",
+			"endpoint.",
+			"",
+			"Important: The Authorization header you provide to this endpoint must have the",
+			"following format:",
+		},
+		ParamDoc: []string{
+			"`merchantID`:",
+			"If you provide this parameter, the endpoint returns only subscription information for",
+			"the specified merchant.You can get a merchant's ID with the Retrieve",
+			"Merchant endpoint.",
+			"",
+			"`limit`:",
+			"The maximum number of subscriptions to return in a single response. This value cannot",
+			"exceed 200.Default value: 100",
+		},
+		Method: "GET",
+		Path:   "/oauth2/clients/{clientID}/subscriptions",
+		Query: []param{
+			{Name: "merchantID", Type: "string"},
+			{Name: "limit", Type: "int"},
+		},
+		Options: "ListSubscriptionsOptions",
+		Result:  "[]*Subscription",
+	},
+	{
+		Name: "RetrieveSubscription",
+		Doc: []string{
+			"Provides comprehensive information for a single subscription, including its payment",
+			"history.",
+			"",
+			"Important: The Authorization header you provide to this endpoint must have the",
+			"following format:",
+		},
+		Method: "GET",
+		Path:   "/oauth2/clients/{clientID}/subscriptions/{subscriptionID}",
+		Result: "*Subscription",
+	},
+	{
+		Name: "ListSubscriptionPlans",
+		Doc: []string{
+			"Provides information for all of an application's subscription plans.",
+			"",
+			"Important: The Authorization header you provide to this endpoint must have the",
+			"following format:",
+		},
+		Method: "GET",
+		Path:   "/oauth2/clients/{clientID}/plans",
+		Result: "[]*SubscriptionPlan",
+	},
+	{
+		Name:   "RetrieveSubscriptionPlan",
+		Method: "GET",
+		Path:   "/oauth2/clients/{clientID}/plans/{planID}",
+		Result: "*SubscriptionPlan",
+	},
+}
@@ -0,0 +1,12 @@
+// Package secrets lists what gosquare and its test recorder never
+// write out in clear: the logs of the one, the cassettes of the other.
+package secrets
+
+// Fields are the JSON fields of request and response bodies holding secrets.
+var Fields = map[string]bool{
+	"access_token":          true,
+	"client_secret":         true,
+	"code":                  true,
+	"signature_key":         true,
+	"webhook_signature_key": true,
+}
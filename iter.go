@@ -0,0 +1,80 @@
+package gosquare
+
+import (
+	"context"
+	"iter"
+)
+
+// The Iter methods return iterators over every result of a list endpoint,
+// fetching the pages Square splits them in as the iteration goes on:
+//
+//	for p, err := range client.IterPayments(ctx, "", locationID, nil) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// An error, from any page, is yielded once and ends the iteration.
+// Breaking out of the loop stops before the next page is fetched,
+// unless pages are prefetched, see Client.Prefetch.
+// Use All to collect the results in a slice.
+
+// All collects the values of seq, stopping after max values if max is positive.
+// It returns the values collected until then along with the first error.
+func All[T any](seq iter.Seq2[T, error], max int) ([]T, error) {
+	var vs []T
+	for v, err := range seq {
+		if err != nil {
+			return vs, err
+		}
+		vs = append(vs, v)
+		if max > 0 && len(vs) >= max {
+			break
+		}
+	}
+	return vs, nil
+}
+
+// IterFrom returns an iterator over the results of nr's page and of every
+// page following it, for example to resume a walk from a cursor:
+//
+//	nr, err := client.ResumeNextRequest(cursor, token)
+//	...
+//	for p, err := range gosquare.IterFrom[*gosquare.Payment](ctx, nr) {
+func IterFrom[T any](ctx context.Context, nr *NextRequest) iter.Seq2[T, error] {
+	return iterate(ctx, nr.client.Prefetch, func(ctx context.Context) ([]T, *NextRequest, error) {
+		var page []T
+		next, err := nr.GetNextRequestContext(ctx, &page)
+		return page, next, err
+	})
+}
+
+// iterate returns an iterator over the results of the first page returned
+// by first and of every page following it, prefetching up to depth pages.
+func iterate[T any](ctx context.Context, depth int, first func(ctx context.Context) ([]T, *NextRequest, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if depth > 0 {
+			prefetch(ctx, depth, first, yield)
+			return
+		}
+		page, nr, err := first(ctx)
+		for {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, v := range page {
+				if !yield(v, nil) {
+					return
+				}
+			}
+			if nr == nil {
+				return
+			}
+			page = nil
+			nr, err = nr.GetNextRequestContext(ctx, &page)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package gosquare
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// IterLocations returns an iterator over the results of ListLocations,
+// following every page.
+func (c *Client) IterLocations(ctx context.Context, token string) iter.Seq2[*Merchant, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Merchant, *NextRequest, error) {
+		return c.ListLocationsContext(ctx, token)
+	})
+}
+
+// IterEmployees returns an iterator over the results of ListEmployeesWithOptions,
+// following every page.
+func (c *Client) IterEmployees(ctx context.Context, token string, opts *ListEmployeesOptions) iter.Seq2[*Employee, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Employee, *NextRequest, error) {
+		return c.ListEmployeesWithOptionsContext(ctx, token, opts)
+	})
+}
+
+// IterRoles returns an iterator over the results of ListRolesWithOptions,
+// following every page.
+func (c *Client) IterRoles(ctx context.Context, token string, opts *ListRolesOptions) iter.Seq2[*EmployeeRole, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*EmployeeRole, *NextRequest, error) {
+		return c.ListRolesWithOptionsContext(ctx, token, opts)
+	})
+}
+
+// IterTimecards returns an iterator over the results of ListTimecardsWithOptions,
+// following every page.
+func (c *Client) IterTimecards(ctx context.Context, token string, opts *ListTimecardsOptions) iter.Seq2[*Timecard, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Timecard, *NextRequest, error) {
+		return c.ListTimecardsWithOptionsContext(ctx, token, opts)
+	})
+}
+
+// IterTimecardEvents returns an iterator over the results of ListTimecardEvents,
+// following every page.
+func (c *Client) IterTimecardEvents(ctx context.Context, token, timecardID string) iter.Seq2[*TimecardEvent, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*TimecardEvent, *NextRequest, error) {
+		return c.ListTimecardEventsContext(ctx, token, timecardID)
+	})
+}
+
+// IterCashDrawerShifts returns an iterator over the results of ListCashDrawerShiftsWithOptions,
+// following every page.
+func (c *Client) IterCashDrawerShifts(ctx context.Context, token, locationID string, opts *ListCashDrawerShiftsOptions) iter.Seq2[*CashDrawerShift, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*CashDrawerShift, *NextRequest, error) {
+		return c.ListCashDrawerShiftsWithOptionsContext(ctx, token, locationID, opts)
+	})
+}
+
+// IterPayments returns an iterator over the results of ListPaymentsWithOptions,
+// following every page.
+func (c *Client) IterPayments(ctx context.Context, token, locationID string, opts *ListPaymentsOptions) iter.Seq2[*Payment, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Payment, *NextRequest, error) {
+		return c.ListPaymentsWithOptionsContext(ctx, token, locationID, opts)
+	})
+}
+
+// IterSettlements returns an iterator over the results of ListSettlementsWithOptions,
+// following every page.
+func (c *Client) IterSettlements(ctx context.Context, token, locationID string, opts *ListSettlementsOptions) iter.Seq2[*Settlement, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Settlement, *NextRequest, error) {
+		return c.ListSettlementsWithOptionsContext(ctx, token, locationID, opts)
+	})
+}
+
+// IterRefunds returns an iterator over the results of ListRefundsWithOptions,
+// following every page.
+func (c *Client) IterRefunds(ctx context.Context, token, locationID string, opts *ListRefundsOptions) iter.Seq2[*Refund, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Refund, *NextRequest, error) {
+		return c.ListRefundsWithOptionsContext(ctx, token, locationID, opts)
+	})
+}
+
+// IterOrders returns an iterator over the results of ListOrdersWithOptions,
+// following every page.
+func (c *Client) IterOrders(ctx context.Context, token, locationID string, opts *ListOrdersOptions) iter.Seq2[*Order, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Order, *NextRequest, error) {
+		return c.ListOrdersWithOptionsContext(ctx, token, locationID, opts)
+	})
+}
+
+// IterBankAccounts returns an iterator over the results of ListBankAccounts,
+// following every page.
+func (c *Client) IterBankAccounts(ctx context.Context, token, locationID string) iter.Seq2[*BankAccount, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*BankAccount, *NextRequest, error) {
+		return c.ListBankAccountsContext(ctx, token, locationID)
+	})
+}
+
+// IterItems returns an iterator over the results of ListItems,
+// following every page.
+func (c *Client) IterItems(ctx context.Context, token, locationID string) iter.Seq2[*Item, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Item, *NextRequest, error) {
+		return c.ListItemsContext(ctx, token, locationID)
+	})
+}
+
+// IterInventory returns an iterator over the results of ListInventory,
+// following every page.
+func (c *Client) IterInventory(ctx context.Context, token, locationID string, limit int) iter.Seq2[*InventoryEntry, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*InventoryEntry, *NextRequest, error) {
+		return c.ListInventoryContext(ctx, token, locationID, limit)
+	})
+}
+
+// IterModifierLists returns an iterator over the results of ListModifierLists,
+// following every page.
+func (c *Client) IterModifierLists(ctx context.Context, token, locationID string) iter.Seq2[*ModifierList, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*ModifierList, *NextRequest, error) {
+		return c.ListModifierListsContext(ctx, token, locationID)
+	})
+}
+
+// IterCategories returns an iterator over the results of ListCategories,
+// following every page.
+func (c *Client) IterCategories(ctx context.Context, token, locationID string) iter.Seq2[*Category, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Category, *NextRequest, error) {
+		return c.ListCategoriesContext(ctx, token, locationID)
+	})
+}
+
+// IterDiscounts returns an iterator over the results of ListDiscounts,
+// following every page.
+func (c *Client) IterDiscounts(ctx context.Context, token, locationID string) iter.Seq2[*Discount, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Discount, *NextRequest, error) {
+		return c.ListDiscountsContext(ctx, token, locationID)
+	})
+}
+
+// IterFees returns an iterator over the results of ListFees,
+// following every page.
+func (c *Client) IterFees(ctx context.Context, token, locationID string) iter.Seq2[*Fee, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Fee, *NextRequest, error) {
+		return c.ListFeesContext(ctx, token, locationID)
+	})
+}
+
+// IterPages returns an iterator over the results of ListPages,
+// following every page.
+func (c *Client) IterPages(ctx context.Context, token, locationID string) iter.Seq2[*Page, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Page, *NextRequest, error) {
+		return c.ListPagesContext(ctx, token, locationID)
+	})
+}
+
+// IterWebhooks returns an iterator over the results of ListWebhooks,
+// following every page.
+func (c *Client) IterWebhooks(ctx context.Context, token, locationID string) iter.Seq2[string, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]string, *NextRequest, error) {
+		return c.ListWebhooksContext(ctx, token, locationID)
+	})
+}
+
+// IterSubscriptions returns an iterator over the results of ListSubscriptionsWithOptions,
+// following every page.
+func (c *Client) IterSubscriptions(ctx context.Context, token, clientID string, opts *ListSubscriptionsOptions) iter.Seq2[*Subscription, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*Subscription, *NextRequest, error) {
+		return c.ListSubscriptionsWithOptionsContext(ctx, token, clientID, opts)
+	})
+}
+
+// IterSubscriptionPlans returns an iterator over the results of ListSubscriptionPlans,
+// following every page.
+func (c *Client) IterSubscriptionPlans(ctx context.Context, token, clientID string) iter.Seq2[*SubscriptionPlan, error] {
+	return iterate(ctx, c.Prefetch, func(ctx context.Context) ([]*SubscriptionPlan, *NextRequest, error) {
+		return c.ListSubscriptionPlansContext(ctx, token, clientID)
+	})
+}
+
+// StreamLocations calls fn with every result of ListLocations, following every page.
+func (c *Client) StreamLocations(ctx context.Context, token string, fn func(*Merchant) error) error {
+	return stream(ctx, c, "ListLocations", "/v1/me/locations", token, fn)
+}
+
+// StreamEmployees calls fn with every result of ListEmployeesWithOptions, following every page.
+func (c *Client) StreamEmployees(ctx context.Context, token string, opts *ListEmployeesOptions, fn func(*Employee) error) error {
+	return stream(ctx, c, "ListEmployees", opts.path(), token, fn)
+}
+
+// StreamRoles calls fn with every result of ListRolesWithOptions, following every page.
+func (c *Client) StreamRoles(ctx context.Context, token string, opts *ListRolesOptions, fn func(*EmployeeRole) error) error {
+	return stream(ctx, c, "ListRoles", opts.path(), token, fn)
+}
+
+// StreamTimecards calls fn with every result of ListTimecardsWithOptions, following every page.
+func (c *Client) StreamTimecards(ctx context.Context, token string, opts *ListTimecardsOptions, fn func(*Timecard) error) error {
+	return stream(ctx, c, "ListTimecards", opts.path(), token, fn)
+}
+
+// StreamTimecardEvents calls fn with every result of ListTimecardEvents, following every page.
+func (c *Client) StreamTimecardEvents(ctx context.Context, token, timecardID string, fn func(*TimecardEvent) error) error {
+	return stream(ctx, c, "ListTimecardEvents", fmt.Sprintf("/v1/me/timecards/%s/events", timecardID), token, fn)
+}
+
+// StreamCashDrawerShifts calls fn with every result of ListCashDrawerShiftsWithOptions, following every page.
+func (c *Client) StreamCashDrawerShifts(ctx context.Context, token, locationID string, opts *ListCashDrawerShiftsOptions, fn func(*CashDrawerShift) error) error {
+	return stream(ctx, c, "ListCashDrawerShifts", opts.path(locationID), token, fn)
+}
+
+// StreamPayments calls fn with every result of ListPaymentsWithOptions, following every page.
+func (c *Client) StreamPayments(ctx context.Context, token, locationID string, opts *ListPaymentsOptions, fn func(*Payment) error) error {
+	return stream(ctx, c, "ListPayments", opts.path(locationID), token, fn)
+}
+
+// StreamSettlements calls fn with every result of ListSettlementsWithOptions, following every page.
+func (c *Client) StreamSettlements(ctx context.Context, token, locationID string, opts *ListSettlementsOptions, fn func(*Settlement) error) error {
+	return stream(ctx, c, "ListSettlements", opts.path(locationID), token, fn)
+}
+
+// StreamRefunds calls fn with every result of ListRefundsWithOptions, following every page.
+func (c *Client) StreamRefunds(ctx context.Context, token, locationID string, opts *ListRefundsOptions, fn func(*Refund) error) error {
+	return stream(ctx, c, "ListRefunds", opts.path(locationID), token, fn)
+}
+
+// StreamOrders calls fn with every result of ListOrdersWithOptions, following every page.
+func (c *Client) StreamOrders(ctx context.Context, token, locationID string, opts *ListOrdersOptions, fn func(*Order) error) error {
+	return stream(ctx, c, "ListOrders", opts.path(locationID), token, fn)
+}
+
+// StreamBankAccounts calls fn with every result of ListBankAccounts, following every page.
+func (c *Client) StreamBankAccounts(ctx context.Context, token, locationID string, fn func(*BankAccount) error) error {
+	return stream(ctx, c, "ListBankAccounts", fmt.Sprintf("/v1/%s/bank-accounts", locationID), token, fn)
+}
+
+// StreamItems calls fn with every result of ListItems, following every page.
+func (c *Client) StreamItems(ctx context.Context, token, locationID string, fn func(*Item) error) error {
+	return stream(ctx, c, "ListItems", fmt.Sprintf("/v1/%s/items", locationID), token, fn)
+}
+
+// StreamInventory calls fn with every result of ListInventory, following every page.
+func (c *Client) StreamInventory(ctx context.Context, token, locationID string, limit int, fn func(*InventoryEntry) error) error {
+	return stream(ctx, c, "ListInventory", listInventoryPath(locationID, limit), token, fn)
+}
+
+// StreamModifierLists calls fn with every result of ListModifierLists, following every page.
+func (c *Client) StreamModifierLists(ctx context.Context, token, locationID string, fn func(*ModifierList) error) error {
+	return stream(ctx, c, "ListModifierLists", fmt.Sprintf("/v1/%s/modifier-lists", locationID), token, fn)
+}
+
+// StreamCategories calls fn with every result of ListCategories, following every page.
+func (c *Client) StreamCategories(ctx context.Context, token, locationID string, fn func(*Category) error) error {
+	return stream(ctx, c, "ListCategories", fmt.Sprintf("/v1/%s/categories", locationID), token, fn)
+}
+
+// StreamDiscounts calls fn with every result of ListDiscounts, following every page.
+func (c *Client) StreamDiscounts(ctx context.Context, token, locationID string, fn func(*Discount) error) error {
+	return stream(ctx, c, "ListDiscounts", fmt.Sprintf("/v1/%s/discounts", locationID), token, fn)
+}
+
+// StreamFees calls fn with every result of ListFees, following every page.
+func (c *Client) StreamFees(ctx context.Context, token, locationID string, fn func(*Fee) error) error {
+	return stream(ctx, c, "ListFees", fmt.Sprintf("/v1/%s/fees", locationID), token, fn)
+}
+
+// StreamPages calls fn with every result of ListPages, following every page.
+func (c *Client) StreamPages(ctx context.Context, token, locationID string, fn func(*Page) error) error {
+	return stream(ctx, c, "ListPages", fmt.Sprintf("/v1/%s/pages", locationID), token, fn)
+}
+
+// StreamWebhooks calls fn with every result of ListWebhooks, following every page.
+func (c *Client) StreamWebhooks(ctx context.Context, token, locationID string, fn func(string) error) error {
+	return stream(ctx, c, "ListWebhooks", fmt.Sprintf("/v1/%s/webhooks", locationID), token, fn)
+}
+
+// StreamSubscriptions calls fn with every result of ListSubscriptionsWithOptions, following every page.
+func (c *Client) StreamSubscriptions(ctx context.Context, token, clientID string, opts *ListSubscriptionsOptions, fn func(*Subscription) error) error {
+	return stream(ctx, c, "ListSubscriptions", opts.path(clientID), token, fn)
+}
+
+// StreamSubscriptionPlans calls fn with every result of ListSubscriptionPlans, following every page.
+func (c *Client) StreamSubscriptionPlans(ctx context.Context, token, clientID string, fn func(*SubscriptionPlan) error) error {
+	return stream(ctx, c, "ListSubscriptionPlans", fmt.Sprintf("/oauth2/clients/%s/plans", clientID), token, fn)
+}
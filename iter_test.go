@@ -0,0 +1,118 @@
+package gosquare_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+)
+
+func paymentIDs(ps []*gosquare.Payment) string {
+	ids := make([]string, len(ps))
+	for i, p := range ps {
+		ids[i] = p.ID
+	}
+	return fmt.Sprint(ids)
+}
+
+func TestAll(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	srv.SetPageSize(2)
+	seedPayments(srv, "L", 5)
+	c := srv.Client()
+	ctx := context.Background()
+
+	ps, err := gosquare.All(c.IterPayments(ctx, "", "L", nil), 0)
+	if got, want := paymentIDs(ps), "[P000 P001 P002 P003 P004]"; err != nil || got != want {
+		t.Errorf("All(seq, 0) = %s, %v, want %s", got, err, want)
+	}
+	before := len(srv.Requests())
+	ps, err = gosquare.All(c.IterPayments(ctx, "", "L", nil), 3)
+	if got, want := paymentIDs(ps), "[P000 P001 P002]"; err != nil || got != want {
+		t.Errorf("All(seq, 3) = %s, %v, want %s", got, err, want)
+	}
+	if n := len(srv.Requests()) - before; n != 2 {
+		t.Errorf("All(seq, 3) fetched %d pages, want 2", n)
+	}
+}
+
+func TestIterBreak(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	srv.SetPageSize(2)
+	seedPayments(srv, "L", 5)
+	c := srv.Client()
+
+	n := 0
+	for _, err := range c.IterPayments(context.Background(), "", "L", nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n++; n == 2 {
+			break
+		}
+	}
+	if pages := len(srv.Requests()); pages != 1 {
+		t.Errorf("breaking at the end of the first page fetched %d pages, want 1", pages)
+	}
+}
+
+func TestIterError(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	srv.SetPageSize(2)
+	seedPayments(srv, "L", 5)
+	c := srv.Client()
+	_, nr, err := c.ListPaymentsWithOptions("", "L", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the page after nr's fails
+	srv.InjectFault(gosquaretest.Fault{Path: "/v1/L/payments", StatusCode: http.StatusInternalServerError, Times: 1})
+	var ids []string
+	errs := 0
+	for p, err := range gosquare.IterFrom[*gosquare.Payment](context.Background(), nr) {
+		if err != nil {
+			errs++
+			if !gosquare.IsRetryable(err) {
+				t.Errorf("got error %v, want a 500", err)
+			}
+			continue
+		}
+		ids = append(ids, p.ID)
+	}
+	if errs != 1 || len(ids) != 0 {
+		t.Errorf("got %v and %d errors, want one error and nothing else", ids, errs)
+	}
+
+	// an error in a later page ends the iteration after the pages before it
+	seedPayments(srv, "M", 7)
+	_, nr, err = c.ListPaymentsWithOptions("", "M", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := len(srv.Requests())
+	ids, errs = nil, 0
+	for p, err := range gosquare.IterFrom[*gosquare.Payment](context.Background(), nr) {
+		if err != nil {
+			errs++
+			if !gosquare.IsRetryable(err) {
+				t.Errorf("got error %v, want a 500", err)
+			}
+			continue
+		}
+		if ids = append(ids, p.ID); len(ids) == 2 {
+			srv.InjectFault(gosquaretest.Fault{Path: "/v1/M/payments", StatusCode: http.StatusInternalServerError, Times: 1})
+		}
+	}
+	if got, want := fmt.Sprint(ids), "[P002 P003]"; got != want || errs != 1 {
+		t.Errorf("got %s and %d errors, want %s and one error", got, errs, want)
+	}
+	if n := len(srv.Requests()) - before; n != 2 {
+		t.Errorf("fetched %d pages, want 2", n)
+	}
+}
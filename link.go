@@ -0,0 +1,216 @@
+package gosquare
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrInvalidLinkHeader is returned, wrapped, when Square paginates a response
+// with a Link header that can't be parsed or that points outside the client's
+// base url.
+var ErrInvalidLinkHeader = errors.New("gosquare: invalid Link header")
+
+// link is a link-value of a Link header, cf. RFC 8288.
+type link struct {
+	uri    string
+	params map[string]string
+}
+
+// hasRel reports whether the link's rel parameter, a space separated
+// list of relation types, holds rel.
+func (l *link) hasRel(rel string) bool {
+	for _, r := range strings.Fields(l.params["rel"]) {
+		if strings.EqualFold(r, rel) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseLinkHeader parses the link-values of a Link header, cf. RFC 8288
+// section 3. Parameter names are lowercased and only the first occurrence
+// of a parameter is kept. Parameter values may be tokens or quoted strings,
+// Square quotes them with single quotes, which are accepted too.
+func parseLinkHeader(header string) ([]*link, error) {
+	var links []*link
+	p := &linkParser{s: header}
+	for {
+		p.skipSpace()
+		if p.done() {
+			return links, nil
+		}
+		if p.peek() == ',' {
+			// empty list elements are allowed
+			p.i++
+			continue
+		}
+		l, err := p.linkValue()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidLinkHeader, err)
+		}
+		links = append(links, l)
+		p.skipSpace()
+		if p.done() {
+			return links, nil
+		}
+		if p.peek() != ',' {
+			return nil, fmt.Errorf("%w: expected ',' at offset %d", ErrInvalidLinkHeader, p.i)
+		}
+		p.i++
+	}
+}
+
+type linkParser struct {
+	s string
+	i int
+}
+
+func (p *linkParser) done() bool {
+	return p.i >= len(p.s)
+}
+
+func (p *linkParser) peek() byte {
+	return p.s[p.i]
+}
+
+func (p *linkParser) skipSpace() {
+	for !p.done() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.i++
+	}
+}
+
+func (p *linkParser) linkValue() (*link, error) {
+	if p.peek() != '<' {
+		return nil, fmt.Errorf("expected '<' at offset %d", p.i)
+	}
+	end := strings.IndexByte(p.s[p.i:], '>')
+	if end < 0 {
+		return nil, fmt.Errorf("unterminated uri at offset %d", p.i)
+	}
+	l := &link{uri: strings.TrimSpace(p.s[p.i+1 : p.i+end]), params: make(map[string]string)}
+	p.i += end + 1
+	for {
+		p.skipSpace()
+		if p.done() || p.peek() == ',' {
+			return l, nil
+		}
+		if p.peek() != ';' {
+			return nil, fmt.Errorf("expected ';' at offset %d", p.i)
+		}
+		p.i++
+		p.skipSpace()
+		if p.done() || p.peek() == ',' {
+			// a trailing ";" is tolerated
+			return l, nil
+		}
+		name := strings.ToLower(p.token())
+		if len(name) == 0 {
+			return nil, fmt.Errorf("expected a parameter name at offset %d", p.i)
+		}
+		var value string
+		p.skipSpace()
+		if !p.done() && p.peek() == '=' {
+			p.i++
+			p.skipSpace()
+			var err error
+			if value, err = p.paramValue(); err != nil {
+				return nil, err
+			}
+		}
+		if _, ok := l.params[name]; !ok {
+			l.params[name] = value
+		}
+	}
+}
+
+// token reads an RFC 7230 token.
+func (p *linkParser) token() string {
+	start := p.i
+	for !p.done() && isTokenChar(p.peek()) {
+		p.i++
+	}
+	return p.s[start:p.i]
+}
+
+func (p *linkParser) paramValue() (string, error) {
+	if p.done() {
+		return "", fmt.Errorf("expected a parameter value at offset %d", p.i)
+	}
+	q := p.peek()
+	if q != '"' && q != '\'' {
+		v := p.token()
+		if len(v) == 0 {
+			return "", fmt.Errorf("expected a parameter value at offset %d", p.i)
+		}
+		return v, nil
+	}
+	start := p.i
+	p.i++
+	var b strings.Builder
+	for !p.done() {
+		c := p.peek()
+		p.i++
+		switch {
+		case c == q:
+			return b.String(), nil
+		case c == '\\' && q == '"' && !p.done():
+			b.WriteByte(p.peek())
+			p.i++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf("unterminated quoted string at offset %d", start)
+}
+
+func isTokenChar(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$%&'*+-.^_`|~", c) > -1
+}
+
+// nextPath returns the path and query of the rel="next" link of a Link header,
+// relative to the client's base url, or "" if there is no such link.
+func (c *Client) nextPath(header string) (string, error) {
+	links, err := parseLinkHeader(header)
+	if err != nil {
+		return "", err
+	}
+	for _, l := range links {
+		if l.hasRel("next") {
+			return c.relativePath(l.uri)
+		}
+	}
+	return "", nil
+}
+
+// relativePath resolves uri against the client's base url and
+// returns it relative to the base url.
+func (c *Client) relativePath(uri string) (string, error) {
+	base, err := url.Parse(c.baseURL())
+	if err != nil {
+		return "", err
+	}
+	ref, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidLinkHeader, err)
+	}
+	u := base.ResolveReference(ref)
+	if !strings.EqualFold(u.Scheme, base.Scheme) || !strings.EqualFold(u.Host, base.Host) {
+		return "", fmt.Errorf("%w: %s is not under %s", ErrInvalidLinkHeader, uri, c.baseURL())
+	}
+	basePath := strings.TrimSuffix(base.EscapedPath(), "/")
+	p := u.EscapedPath()
+	if !strings.HasPrefix(p, basePath+"/") {
+		return "", fmt.Errorf("%w: %s is not under %s", ErrInvalidLinkHeader, uri, c.baseURL())
+	}
+	p = p[len(basePath):]
+	if len(u.RawQuery) > 0 {
+		p += "?" + u.RawQuery
+	}
+	return p, nil
+}
@@ -0,0 +1,93 @@
+package gosquare
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNextPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		header  string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", header: "", want: ""},
+		{name: "short", header: "<", wantErr: true},
+		{name: "empty next uri", header: "<>;rel=next", wantErr: true},
+		{name: "no rel", header: "<https://connect.squareup.com/v1/L/payments?batch_token=X>", want: ""},
+		{
+			name:   "next",
+			header: `<https://connect.squareup.com/v1/L/payments?batch_token=X>;rel="next"`,
+			want:   "/v1/L/payments?batch_token=X",
+		},
+		{
+			name:   "single quoted rel",
+			header: "<https://connect.squareup.com/v1/L/payments?batch_token=X>;rel='next'",
+			want:   "/v1/L/payments?batch_token=X",
+		},
+		{
+			name:   "prev and next",
+			header: `<https://connect.squareup.com/v1/L/payments?batch_token=A>; rel="prev", <https://connect.squareup.com/v1/L/payments?batch_token=B>; rel="next"`,
+			want:   "/v1/L/payments?batch_token=B",
+		},
+		{
+			name: "multiple links",
+			header: `<https://connect.squareup.com/v1/L/payments>; rel="first", ` +
+				`<https://connect.squareup.com/v1/L/payments?batch_token=N>; title="page 2"; rel="previous next", ` +
+				`<https://connect.squareup.com/v1/L/payments?batch_token=Z>; rel="last"`,
+			want: "/v1/L/payments?batch_token=N",
+		},
+		{
+			name:    "sandbox",
+			baseURL: SandboxURL,
+			header:  "<https://connect.squareupsandbox.com/v1/L/payments?batch_token=X>;rel='next'",
+			want:    "/v1/L/payments?batch_token=X",
+		},
+		{
+			name:    "other host with a base path",
+			baseURL: "http://127.0.0.1:8080/square",
+			header:  "<http://127.0.0.1:8080/square/v1/L/payments?batch_token=X>;rel='next'",
+			want:    "/v1/L/payments?batch_token=X",
+		},
+		{
+			name:   "relative uri",
+			header: "</v1/L/payments?batch_token=X>;rel='next'",
+			want:   "/v1/L/payments?batch_token=X",
+		},
+		{
+			name:    "different host",
+			header:  "<https://evil.example.com/v1/L/payments?batch_token=X>;rel='next'",
+			wantErr: true,
+		},
+		{
+			name:    "sandbox link on production",
+			header:  "<https://connect.squareupsandbox.com/v1/L/payments?batch_token=X>;rel='next'",
+			wantErr: true,
+		},
+		{
+			name:    "outside the base path",
+			baseURL: "http://127.0.0.1:8080/square",
+			header:  "<http://127.0.0.1:8080/v1/L/payments?batch_token=X>;rel='next'",
+			wantErr: true,
+		},
+		{name: "missing comma", header: `<https://connect.squareup.com/a>;rel="prev" <https://connect.squareup.com/b>;rel="next"`, wantErr: true},
+		{name: "unterminated quote", header: `<https://connect.squareup.com/v1/L/payments>;rel="next`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{BaseURL: tt.baseURL}
+			got, err := c.nextPath(tt.header)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidLinkHeader) {
+					t.Fatalf("nextPath(%q) = %q, %v, want an ErrInvalidLinkHeader", tt.header, got, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("nextPath(%q) = %q, %v, want %q", tt.header, got, err, tt.want)
+			}
+		})
+	}
+}
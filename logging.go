@@ -0,0 +1,193 @@
+package gosquare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nathanjsweet/gosquare/internal/secrets"
+)
+
+// _Redacted replaces every secret in what this lib logs.
+const _Redacted = "[REDACTED]"
+
+// logged wraps h so every call is logged to the client's Logger, if any.
+// Successful calls are logged at LevelInfo, failed ones at LevelWarn, and with
+// LevelDebug enabled the redacted request bodies, batched ones included,
+// and every retry are logged too.
+func (c *Client) logged(h Handler) Handler {
+	return func(ctx context.Context, r *Request) (*Response, error) {
+		l := c.Logger
+		if l == nil {
+			return h(ctx, r)
+		}
+		start := time.Now()
+		resp, err := h(ctx, r)
+		attrs := []slog.Attr{
+			slog.String("method", r.Method),
+			slog.String("path", r.Path),
+			slog.Duration("latency", time.Since(start)),
+		}
+		if r.Page > 0 {
+			attrs = append(attrs, slog.Int("page", r.Page))
+		}
+		debug := l.Enabled(ctx, slog.LevelDebug)
+		if r.Batch != nil {
+			attrs = append(attrs, slog.Any("batch", batchLogValue(r.Batch, debug)))
+		}
+		if debug && len(r.Body) > 0 && r.ContentType == "application/json" {
+			attrs = append(attrs, slog.String("body", redactJSON(r.Body)))
+		}
+		if err != nil {
+			if e, ok := asAPIError(err); ok {
+				attrs = append(attrs, slog.Int("status", e.StatusCode))
+				if id := e.Header.Get("X-Request-Id"); len(id) > 0 {
+					attrs = append(attrs, slog.String("request_id", id))
+				}
+			}
+			attrs = append(attrs, slog.String("error", err.Error()))
+			l.LogAttrs(ctx, slog.LevelWarn, "square request failed", attrs...)
+			return resp, err
+		}
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		if id := resp.Header.Get("X-Request-Id"); len(id) > 0 {
+			attrs = append(attrs, slog.String("request_id", id))
+		}
+		attrs = append(attrs, slog.Bool("has_next_page", resp.NextRequest != nil))
+		l.LogAttrs(ctx, slog.LevelInfo, "square request", attrs...)
+		return resp, err
+	}
+}
+
+func (c *Client) logRetry(ctx context.Context, method, path string, attempt int, wait time.Duration, err error) {
+	if c.Logger == nil {
+		return
+	}
+	c.Logger.LogAttrs(ctx, slog.LevelDebug, "square request retry",
+		slog.String("method", method),
+		slog.String("path", path),
+		slog.Int("attempt", attempt),
+		slog.Duration("wait", wait),
+		slog.String("error", err.Error()),
+	)
+}
+
+// LogValue implements slog.LogValuer, the access token is always redacted.
+func (br BatchRequest) LogValue() slog.Value {
+	return br.logValue(true)
+}
+
+// logValue is LogValue, leaving the body out unless withBody is set.
+func (br BatchRequest) logValue(withBody bool) slog.Value {
+	attrs := []slog.Attr{
+		slog.String("method", br.Method),
+		slog.String("relative_path", br.RelativePath),
+		slog.String("request_id", br.RequestID),
+		slog.String("access_token", redactToken(br.AccessToken)),
+	}
+	if withBody && br.Body != nil {
+		if bts, err := json.Marshal(br.Body); err == nil {
+			attrs = append(attrs, slog.String("body", redactJSON(bts)))
+		}
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// String returns a description of the BatchRequest with its access token redacted,
+// so a BatchRequest can be printed without leaking it.
+func (br BatchRequest) String() string {
+	return fmt.Sprintf("%s %s (request_id=%s, access_token=%s)", br.Method, br.RelativePath, br.RequestID, redactToken(br.AccessToken))
+}
+
+// GoString implements fmt.GoStringer so %#v redacts the access token too,
+// the body is left out.
+func (br BatchRequest) GoString() string {
+	return fmt.Sprintf("gosquare.BatchRequest{Method:%q, RelativePath:%q, AccessToken:%q, RequestID:%q}",
+		br.Method, br.RelativePath, redactToken(br.AccessToken), br.RequestID)
+}
+
+// LogValue implements slog.LogValuer, the token is always left out
+// and secrets in the body redacted.
+func (r Request) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("method", r.Method),
+		slog.String("path", r.Path),
+		slog.String("token", redactToken(r.Token)),
+	}
+	if len(r.Body) > 0 {
+		if r.ContentType == "application/json" {
+			attrs = append(attrs, slog.String("body", redactJSON(r.Body)))
+		} else {
+			attrs = append(attrs, slog.Int("body_size", len(r.Body)))
+		}
+	}
+	if r.Batch != nil {
+		attrs = append(attrs, slog.Any("batch", batchLogValue(r.Batch, true)))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// String returns a description of the Request with its token redacted and
+// its body left out, so a Request can be printed without leaking secrets.
+func (r Request) String() string {
+	return fmt.Sprintf("%s %s (operation=%s, token=%s, body_size=%d, batch_size=%d, page=%d)",
+		r.Method, r.Path, r.Operation, redactToken(r.Token), len(r.Body), len(r.Batch), r.Page)
+}
+
+// GoString implements fmt.GoStringer so %#v redacts the token too,
+// the body and batched requests are only counted.
+func (r Request) GoString() string {
+	return fmt.Sprintf("gosquare.Request{Operation:%q, Method:%q, Path:%q, Token:%q, ContentType:%q, Body:[%d bytes], Batch:[%d requests], Page:%d}",
+		r.Operation, r.Method, r.Path, redactToken(r.Token), r.ContentType, len(r.Body), len(r.Batch), r.Page)
+}
+
+// batchLogValue describes the batched requests brs, with their bodies if withBody is set.
+func batchLogValue(brs []*BatchRequest, withBody bool) slog.Value {
+	attrs := make([]slog.Attr, 0, len(brs)+1)
+	attrs = append(attrs, slog.Int("size", len(brs)))
+	for i, br := range brs {
+		attrs = append(attrs, slog.Attr{Key: fmt.Sprint(i), Value: br.logValue(withBody)})
+	}
+	return slog.GroupValue(attrs...)
+}
+
+func redactToken(token string) string {
+	if len(token) == 0 {
+		return ""
+	}
+	return _Redacted
+}
+
+// redactJSON returns bts with the values of every secret field replaced,
+// or a placeholder if bts isn't valid JSON.
+func redactJSON(bts []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(bts, &v); err != nil {
+		return fmt.Sprintf("[%d bytes]", len(bts))
+	}
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return fmt.Sprintf("[%d bytes]", len(bts))
+	}
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, sub := range t {
+			if secrets.Fields[k] {
+				t[k] = _Redacted
+			} else {
+				t[k] = redactValue(sub)
+			}
+		}
+	case []interface{}:
+		for i, sub := range t {
+			t[i] = redactValue(sub)
+		}
+	}
+	return v
+}
@@ -0,0 +1,54 @@
+package gosquare_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+)
+
+func TestRequestsRedactTokens(t *testing.T) {
+	const secret = "SECRET_TOKEN"
+	br := gosquare.BatchRequest{Method: "GET", RelativePath: "/v1/me", AccessToken: secret, RequestID: "1"}
+	r := gosquare.Request{Operation: "SubmitBatch", Method: "POST", Path: "/v1/batch", Token: secret,
+		ContentType: "application/json", Body: []byte(`{"access_token":"` + secret + `"}`), Batch: []*gosquare.BatchRequest{&br}}
+	for _, v := range []interface{}{br, &br, r, &r} {
+		for _, verb := range []string{"%v", "%+v", "%#v", "%s"} {
+			if s := fmt.Sprintf(verb, v); strings.Contains(s, secret) {
+				t.Errorf("%s of %T leaks the token: %s", verb, v, s)
+			}
+		}
+		var buf bytes.Buffer
+		slog.New(slog.NewTextHandler(&buf, nil)).Info("call", "v", v)
+		if strings.Contains(buf.String(), secret) {
+			t.Errorf("logging %T leaks the token: %s", v, buf.String())
+		}
+	}
+}
+
+func TestLoggedBatchBodies(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	for _, tt := range []struct {
+		level    slog.Level
+		wantBody bool
+	}{
+		{slog.LevelInfo, false},
+		{slog.LevelDebug, true},
+	} {
+		var buf bytes.Buffer
+		c := srv.Client()
+		c.Logger = slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: tt.level}))
+		br, _ := c.CreateItemBatchRequest("", "L", &gosquare.CreateItemReqObject{ID: "I1", Name: "Tea"})
+		if _, err := c.SubmitBatch("", []*gosquare.BatchRequest{br}); err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Contains(buf.String(), "Tea"); got != tt.wantBody {
+			t.Errorf("logging at %s, the batched request body is logged: %t, want %t\n%s", tt.level, got, tt.wantBody, buf.String())
+		}
+	}
+}
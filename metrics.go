@@ -0,0 +1,54 @@
+package gosquare
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Metrics collects measurements of a Client's calls. Set it as Client.Metrics,
+// see the promsquare package for a Prometheus-compatible implementation.
+// Operations are the names of the endpoint functions, for example
+// "ListPayments", see Request.Operation.
+//
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// ObserveRequest records a finished call: its status class ("2xx", "4xx",
+	// "5xx", ... or "error" if no response was received) and how long it
+	// took, retries included.
+	ObserveRequest(operation, statusClass string, latency time.Duration)
+	// IncRetries records that a call is about to be retried.
+	IncRetries(operation string)
+	// IncPages records that a page was fetched through a NextRequest.
+	IncPages(operation string)
+}
+
+// measured wraps h so every call is recorded by the client's Metrics, if any.
+func (c *Client) measured(h Handler) Handler {
+	return func(ctx context.Context, r *Request) (*Response, error) {
+		m := c.Metrics
+		if m == nil {
+			return h(ctx, r)
+		}
+		start := time.Now()
+		resp, err := h(ctx, r)
+		m.ObserveRequest(r.Operation, statusClass(resp, err), time.Since(start))
+		if r.Page > 0 {
+			m.IncPages(r.Operation)
+		}
+		return resp, err
+	}
+}
+
+func statusClass(resp *Response, err error) string {
+	code := 0
+	if resp != nil {
+		code = resp.StatusCode
+	} else if e, ok := asAPIError(err); ok {
+		code = e.StatusCode
+	}
+	if code < 100 || code >= 600 {
+		return "error"
+	}
+	return fmt.Sprintf("%dxx", code/100)
+}
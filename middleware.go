@@ -0,0 +1,84 @@
+package gosquare
+
+import (
+	"context"
+	"net/http"
+)
+
+// Request is a single call to Square as it travels through a Client's
+// Middleware. Every call goes through the same pipeline: the endpoints,
+// NextRequest pages, SubmitBatch, the oauth2 calls and UploadItemImage.
+// The calls gathered by a Coalescer go through it together, as a single
+// SubmitBatch Request.
+type Request struct {
+	// The name of the endpoint being called, the name of its function,
+	// for example "ListPayments".
+	Operation string
+	// The HTTP method of the request (DELETE, GET, POST, or PUT).
+	Method string
+	// The path of the request, relative to the client's base url,
+	// including the query string if any.
+	Path string
+	// The access token, or the application secret for the oauth2 endpoints,
+	// sent in the "Authorization" header.
+	Token string
+	// The Content-Type of Body, only sent with POST and PUT requests.
+	ContentType string
+	// The encoded request body, if any.
+	Body []byte
+	// Extra headers sent with the request, they override the
+	// headers set by the Client.
+	Header http.Header
+	// The value the response body is decoded into, nil for DELETE requests.
+	// This is the value the endpoint returns, replacing it has no effect
+	// on what the endpoint returns.
+	Result interface{}
+	// The batched requests if this is a call to SubmitBatch.
+	Batch []*BatchRequest
+	// The number of NextRequest pages followed to get to this request,
+	// 0 if it isn't a NextRequest.
+	Page int
+}
+
+// Response is the outcome of a successful Request.
+// Calls that fail return an error instead, an *APIError if Square
+// answered with a non-2xx status.
+type Response struct {
+	// The response's HTTP status code.
+	StatusCode int
+	// The response's headers.
+	Header http.Header
+	// The decoded response body, the Request's Result.
+	Result interface{}
+	// The request for the next page of results, if any.
+	NextRequest *NextRequest
+}
+
+// Handler makes a Request, the innermost Handler of a Client sends it to Square
+// applying the client's RetryPolicy and RateLimiter.
+type Handler func(ctx context.Context, r *Request) (*Response, error)
+
+// Middleware wraps a Handler to observe or change the requests and responses that
+// go through it, much like an http.RoundTripper wraps another. A Middleware may
+// change the Request before passing it on, for example to add headers, and sees
+// the decoded Response or the error of every call.
+//
+//	client.Middleware = append(client.Middleware, func(next gosquare.Handler) gosquare.Handler {
+//		return func(ctx context.Context, r *gosquare.Request) (*gosquare.Response, error) {
+//			resp, err := next(ctx, r)
+//			audit(r.Method, r.Path, err)
+//			return resp, err
+//		}
+//	})
+type Middleware func(next Handler) Handler
+
+// handler returns the client's Middleware chain around send, the first
+// Middleware being the outermost. Tracing, metrics and logging sit right
+// around send so they record the requests as the Middleware left them.
+func (c *Client) handler() Handler {
+	h := c.traced(c.measured(c.logged(c.send)))
+	for i := len(c.Middleware) - 1; i >= 0; i-- {
+		h = c.Middleware[i](h)
+	}
+	return h
+}
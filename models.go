@@ -1,5 +1,7 @@
 package gosquare
 
+import "encoding/json"
+
 // Represents a merchant's bank account.
 type BankAccount struct {
 	// The bank account's Square-issued ID.
@@ -42,7 +44,10 @@ type BatchRequest struct {
 	// BatchResponse.
 	RequestID string `json:"request_id"`
 
-	result interface{}
+	operation string
+	result    interface{}
+	// the response to the request, set by SubmitBatch
+	response *BatchResponse
 }
 
 // Represents the response for a request included in a call to the Submit Batch endpoint.
@@ -51,14 +56,20 @@ type BatchResponse struct {
 	StatusCode int `json:"status_code"`
 	// Contains any important headers for the response, indexed by header name. For example,
 	// if the response includes a pagination header, the
-	// header's value is available from headers["Link"].
-	Headers interface{} `json:"headers"`
+	// header's value is available from Headers.Get("Link").
+	Headers BatchHeaders `json:"headers"`
 	// The body of the response, if any.
 	Body interface{} `json:"body"`
 	// The value you provided for request_id in the corresponding BatchRequest, if any.
 	RequestID string `json:"request_id"`
 	// Specific to this library
 	NextRequest *NextRequest `json:"-"`
+
+	request *BatchRequest
+	// the undecoded body, see UnmarshalJSON
+	body json.RawMessage
+	// the error reading a successful response, see Err
+	err error
 }
 
 // Represents geographic coordinates.
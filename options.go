@@ -0,0 +1,212 @@
+package gosquare
+
+import (
+	"time"
+)
+
+// SortOrder is the order in which a list endpoint returns its results.
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "ASC"
+	SortDesc SortOrder = "DESC"
+)
+
+// EmployeeStatus is the status of an Employee.
+type EmployeeStatus string
+
+const (
+	EmployeeActive   EmployeeStatus = "ACTIVE"
+	EmployeeInactive EmployeeStatus = "INACTIVE"
+)
+
+// SettlementStatus is the status of a Settlement.
+type SettlementStatus string
+
+const (
+	SettlementSent   SettlementStatus = "SENT"
+	SettlementFailed SettlementStatus = "FAILED"
+)
+
+// The options of the list endpoints. Their zero values leave the matching
+// filter unset, letting Square apply its default, and a nil options
+// pointer leaves them all unset. Time ranges include their beginning and
+// exclude their end.
+
+// ListEmployeesOptions are the filters of ListEmployeesWithOptions.
+type ListEmployeesOptions struct {
+	Order SortOrder
+	// Only employees updated in [BeginUpdatedAt, EndUpdatedAt).
+	BeginUpdatedAt time.Time
+	EndUpdatedAt   time.Time
+	// Only employees created in [BeginCreatedAt, EndCreatedAt).
+	BeginCreatedAt time.Time
+	EndCreatedAt   time.Time
+	// Only employees with this status.
+	Status EmployeeStatus
+	// Only employees with this external_id.
+	ExternalID string
+	// The maximum number of employees per page, at most 200. Default value: 100
+	Limit int
+}
+
+func (o *ListEmployeesOptions) path() string {
+	if o == nil {
+		o = new(ListEmployeesOptions)
+	}
+	return listEmployeesPath(string(o.Order), formatTime(o.BeginUpdatedAt), formatTime(o.EndUpdatedAt),
+		formatTime(o.BeginCreatedAt), formatTime(o.EndCreatedAt), string(o.Status), o.ExternalID, o.Limit)
+}
+
+// ListRolesOptions are the filters of ListRolesWithOptions.
+type ListRolesOptions struct {
+	Order SortOrder
+	// The maximum number of roles per page, at most 200. Default value: 100
+	Limit int
+}
+
+func (o *ListRolesOptions) path() string {
+	if o == nil {
+		o = new(ListRolesOptions)
+	}
+	return listRolesPath(string(o.Order), o.Limit)
+}
+
+// ListTimecardsOptions are the filters of ListTimecardsWithOptions.
+type ListTimecardsOptions struct {
+	Order SortOrder
+	// Only the timecards of this employee.
+	EmployeeID string
+	// Only timecards clocked in in [BeginClockinTime, EndClockinTime).
+	BeginClockinTime time.Time
+	EndClockinTime   time.Time
+	// Only timecards clocked out in [BeginClockoutTime, EndClockoutTime).
+	BeginClockoutTime time.Time
+	EndClockoutTime   time.Time
+	// Only timecards updated in [BeginUpdatedAt, EndUpdatedAt).
+	BeginUpdatedAt time.Time
+	EndUpdatedAt   time.Time
+	// If set, only deleted timecards if true and only valid ones if false.
+	// Both are returned if nil.
+	Deleted *bool
+	// The maximum number of timecards per page, at most 200.
+	Limit int
+}
+
+func (o *ListTimecardsOptions) path() string {
+	if o == nil {
+		o = new(ListTimecardsOptions)
+	}
+	return listTimecardsPath(string(o.Order), o.EmployeeID, formatTime(o.BeginClockinTime), formatTime(o.EndClockinTime),
+		formatTime(o.BeginClockoutTime), formatTime(o.EndClockoutTime), formatTime(o.BeginUpdatedAt), formatTime(o.EndUpdatedAt),
+		o.Deleted, o.Limit)
+}
+
+// ListCashDrawerShiftsOptions are the filters of ListCashDrawerShiftsWithOptions.
+type ListCashDrawerShiftsOptions struct {
+	// Only shifts in [BeginTime, EndTime), at most 90 days apart.
+	// Default value: the last 90 days
+	BeginTime time.Time
+	EndTime   time.Time
+	Order     SortOrder
+}
+
+func (o *ListCashDrawerShiftsOptions) path(locationID string) string {
+	if o == nil {
+		o = new(ListCashDrawerShiftsOptions)
+	}
+	return listCashDrawerShiftsPath(locationID, formatTime(o.BeginTime), formatTime(o.EndTime), string(o.Order))
+}
+
+// ListPaymentsOptions are the filters of ListPaymentsWithOptions.
+type ListPaymentsOptions struct {
+	// Only payments in [BeginTime, EndTime), at most a year apart.
+	// Default value: the last year
+	BeginTime time.Time
+	EndTime   time.Time
+	Order     SortOrder
+	// The maximum number of payments per page, at most 200. Default value: 100
+	Limit int
+}
+
+func (o *ListPaymentsOptions) path(locationID string) string {
+	if o == nil {
+		o = new(ListPaymentsOptions)
+	}
+	return listPaymentsPath(locationID, formatTime(o.BeginTime), formatTime(o.EndTime), string(o.Order), o.Limit)
+}
+
+// ListSettlementsOptions are the filters of ListSettlementsWithOptions.
+type ListSettlementsOptions struct {
+	// Only settlements in [BeginTime, EndTime), at most a year apart.
+	// Default value: the last year
+	BeginTime time.Time
+	EndTime   time.Time
+	Order     SortOrder
+	// The maximum number of settlements per page, at most 200. Default value: 100
+	Limit int
+	// Only settlements with this status.
+	Status SettlementStatus
+}
+
+func (o *ListSettlementsOptions) path(locationID string) string {
+	if o == nil {
+		o = new(ListSettlementsOptions)
+	}
+	return listSettlementsPath(locationID, formatTime(o.BeginTime), formatTime(o.EndTime), string(o.Order), o.Limit, string(o.Status))
+}
+
+// ListRefundsOptions are the filters of ListRefundsWithOptions.
+type ListRefundsOptions struct {
+	// Only refunds in [BeginTime, EndTime), at most a year apart.
+	// Default value: the last year
+	BeginTime time.Time
+	EndTime   time.Time
+	Order     SortOrder
+	// The maximum number of refunds per page, at most 200. Default value: 100
+	Limit int
+}
+
+func (o *ListRefundsOptions) path(locationID string) string {
+	if o == nil {
+		o = new(ListRefundsOptions)
+	}
+	return listRefundsPath(locationID, formatTime(o.BeginTime), formatTime(o.EndTime), string(o.Order), o.Limit)
+}
+
+// ListOrdersOptions are the filters of ListOrdersWithOptions.
+type ListOrdersOptions struct {
+	Order SortOrder
+	// The maximum number of orders per page, at most 200. Default value: 100
+	Limit int
+}
+
+func (o *ListOrdersOptions) path(locationID string) string {
+	if o == nil {
+		o = new(ListOrdersOptions)
+	}
+	return listOrdersPath(locationID, o.Limit, string(o.Order))
+}
+
+// ListSubscriptionsOptions are the filters of ListSubscriptionsWithOptions.
+type ListSubscriptionsOptions struct {
+	// Only the subscriptions of this merchant.
+	MerchantID string
+	// The maximum number of subscriptions per page, at most 200. Default value: 100
+	Limit int
+}
+
+func (o *ListSubscriptionsOptions) path(clientID string) string {
+	if o == nil {
+		o = new(ListSubscriptionsOptions)
+	}
+	return listSubscriptionsPath(clientID, o.MerchantID, o.Limit)
+}
+
+// formatTime formats t as an ISO 8601 timestamp, "" for the zero time.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
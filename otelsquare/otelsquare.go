@@ -0,0 +1,70 @@
+// Package otelsquare adapts OpenTelemetry tracing to gosquare.Tracer,
+// so the gosquare package itself doesn't depend on OpenTelemetry.
+//
+//	client.Tracer = otelsquare.New(otel.GetTracerProvider())
+package otelsquare
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nathanjsweet/gosquare"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the OpenTelemetry tracer used by New.
+const InstrumentationName = "github.com/nathanjsweet/gosquare"
+
+// Tracer is a gosquare.Tracer recording spans with an OpenTelemetry tracer.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// New returns a Tracer that records spans with tp's InstrumentationName tracer.
+func New(tp trace.TracerProvider) *Tracer {
+	return &Tracer{tracer: tp.Tracer(InstrumentationName)}
+}
+
+// Start implements gosquare.Tracer, its spans are client spans.
+func (t *Tracer) Start(ctx context.Context, name string, attrs ...gosquare.Attribute) (context.Context, gosquare.Span) {
+	ctx, s := t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(convert(attrs)...),
+	)
+	return ctx, span{s}
+}
+
+type span struct {
+	s trace.Span
+}
+
+func (s span) SetAttributes(attrs ...gosquare.Attribute) {
+	s.s.SetAttributes(convert(attrs)...)
+}
+
+func (s span) End(err error) {
+	if err != nil {
+		s.s.RecordError(err)
+		s.s.SetStatus(codes.Error, err.Error())
+	}
+	s.s.End()
+}
+
+func convert(attrs []gosquare.Attribute) []attribute.KeyValue {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		switch v := a.Value.(type) {
+		case string:
+			kvs = append(kvs, attribute.String(a.Key, v))
+		case int:
+			kvs = append(kvs, attribute.Int(a.Key, v))
+		case bool:
+			kvs = append(kvs, attribute.Bool(a.Key, v))
+		default:
+			kvs = append(kvs, attribute.String(a.Key, fmt.Sprint(v)))
+		}
+	}
+	return kvs
+}
@@ -0,0 +1,90 @@
+package otelsquare_test
+
+import (
+	"testing"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+	"github.com/nathanjsweet/gosquare/otelsquare"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	srv.SeedPayments("L", &gosquare.Payment{ID: "P000"})
+	c := srv.Client()
+	c.Tracer = otelsquare.New(tp)
+
+	found := c.RetrievePaymentBatchCall("", "L", "P000")
+	missing := c.RetrievePaymentBatchCall("", "L", "missing")
+	if _, err := c.SubmitBatch("", []*gosquare.BatchRequest{found.BatchRequest, missing.BatchRequest}); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := sr.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("recorded %d spans, want 3", len(spans))
+	}
+	var batch sdktrace.ReadOnlySpan
+	for _, s := range spans {
+		if s.Name() == "gosquare.SubmitBatch" {
+			batch = s
+		}
+	}
+	if batch == nil {
+		t.Fatal("no gosquare.SubmitBatch span")
+	}
+	if batch.InstrumentationScope().Name != otelsquare.InstrumentationName {
+		t.Errorf("instrumentation scope = %s", batch.InstrumentationScope().Name)
+	}
+	if !hasAttribute(batch, attribute.Int(gosquare.AttrBatchSize, 2)) {
+		t.Errorf("batch span attributes = %v", batch.Attributes())
+	}
+	children := 0
+	for _, s := range spans {
+		if s.SpanKind() != trace.SpanKindClient {
+			t.Errorf("%s is a %s span, want a client span", s.Name(), s.SpanKind())
+		}
+		if s == batch {
+			continue
+		}
+		children++
+		if s.Parent().SpanID() != batch.SpanContext().SpanID() {
+			t.Errorf("%s isn't a child of the batch span", s.Name())
+		}
+		switch {
+		case hasAttribute(s, attribute.Int(gosquare.AttrStatusCode, 200)):
+			if s.Status().Code == codes.Error {
+				t.Errorf("the found payment's span has status %v", s.Status())
+			}
+		case hasAttribute(s, attribute.Int(gosquare.AttrStatusCode, 404)):
+			if s.Status().Code != codes.Error || len(s.Events()) == 0 {
+				t.Errorf("the missing payment's span has status %v and events %v, want an error", s.Status(), s.Events())
+			}
+		default:
+			t.Errorf("%s attributes = %v, want a status code", s.Name(), s.Attributes())
+		}
+		if !hasAttribute(s, attribute.String(gosquare.AttrLocationID, "L")) {
+			t.Errorf("%s attributes = %v, want location L", s.Name(), s.Attributes())
+		}
+	}
+	if children != 2 {
+		t.Errorf("recorded %d batched request spans, want 2", children)
+	}
+}
+
+func hasAttribute(s sdktrace.ReadOnlySpan, kv attribute.KeyValue) bool {
+	for _, a := range s.Attributes() {
+		if a == kv {
+			return true
+		}
+	}
+	return false
+}
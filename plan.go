@@ -0,0 +1,159 @@
+package gosquare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// BatchPlan is an ordered list of batch calls that can be saved as JSON and
+// executed later, by another process:
+//
+//	var plan gosquare.BatchPlan
+//	plan.Add(client.CreateItemBatchCall("", locationID, item).BatchRequest)
+//	...
+//	bts, err := json.Marshal(&plan)
+//
+// and, overnight:
+//
+//	var plan gosquare.BatchPlan
+//	err := json.Unmarshal(bts, &plan)
+//	...
+//	outcomes, err := client.ExecuteBatchPlan(token, &plan, 4)
+//
+// The JSON holds each call's operation, method, relative path, body and
+// request id, but not its access token: the calls are made with the token
+// ExecuteBatchPlan is given. A reloaded call's result has the type its
+// BatchCall method gives it, get it with a BatchCall:
+//
+//	call := &gosquare.BatchCall[*gosquare.Item]{BatchRequest: plan.Requests()[0]}
+//	item, err := call.Result()
+//
+// The body of a reloaded call is kept as the JSON it was saved as.
+type BatchPlan struct {
+	requests []*BatchRequest
+}
+
+type plannedCall struct {
+	Operation    string          `json:"operation"`
+	Method       string          `json:"method"`
+	RelativePath string          `json:"relative_path"`
+	Body         json.RawMessage `json:"body,omitempty"`
+	RequestID    string          `json:"request_id"`
+}
+
+// Add appends batch requests to the plan. They must have been returned by the
+// BatchRequest or BatchCall methods, which know their operation.
+func (p *BatchPlan) Add(batchRequests ...*BatchRequest) {
+	p.requests = append(p.requests, batchRequests...)
+}
+
+// Requests returns the requests of the plan, in order.
+func (p *BatchPlan) Requests() []*BatchRequest {
+	return p.requests
+}
+
+// MarshalJSON encodes the calls of the plan, without their access tokens.
+func (p *BatchPlan) MarshalJSON() ([]byte, error) {
+	calls := make([]plannedCall, len(p.requests))
+	for i, br := range p.requests {
+		if _, ok := newBatchResult(br.operation); !ok {
+			return nil, fmt.Errorf("gosquare: batch plan call %d has no known operation, it must be built by a BatchRequest method", i)
+		}
+		calls[i] = plannedCall{
+			Operation:    br.operation,
+			Method:       br.Method,
+			RelativePath: br.RelativePath,
+			RequestID:    br.RequestID,
+		}
+		if br.Body != nil {
+			body, err := json.Marshal(br.Body)
+			if err != nil {
+				return nil, err
+			}
+			calls[i].Body = body
+		}
+	}
+	return json.Marshal(struct {
+		Calls []plannedCall `json:"calls"`
+	}{calls})
+}
+
+// UnmarshalJSON replaces the calls of the plan with those encoded by
+// MarshalJSON, binding each to a new result of its operation's type.
+func (p *BatchPlan) UnmarshalJSON(bts []byte) error {
+	var v struct {
+		Calls []plannedCall `json:"calls"`
+	}
+	if err := json.Unmarshal(bts, &v); err != nil {
+		return err
+	}
+	reqs := make([]*BatchRequest, len(v.Calls))
+	for i, call := range v.Calls {
+		result, ok := newBatchResult(call.Operation)
+		if !ok {
+			return fmt.Errorf("gosquare: batch plan call %d has an unknown operation %q", i, call.Operation)
+		}
+		var body interface{}
+		if len(call.Body) > 0 {
+			body = call.Body
+		}
+		reqs[i] = &BatchRequest{
+			Method:       call.Method,
+			RelativePath: call.RelativePath,
+			Body:         body,
+			RequestID:    call.RequestID,
+			operation:    call.Operation,
+			result:       result,
+		}
+	}
+	p.requests = reqs
+	return nil
+}
+
+// BatchOutcome is the outcome of a call of a BatchPlan.
+type BatchOutcome struct {
+	// The call's request.
+	Request *BatchRequest
+	// The call's response, nil if it has none.
+	Response *BatchResponse
+	// The call's error: the *BatchChunkError of its chunk if the chunk
+	// failed, ErrNoBatchResponse if Square left it out of the batch response,
+	// the *APIError of its response if it failed, nil if it succeeded.
+	Err error
+}
+
+// ExecuteBatchPlan makes the calls of plan with token, in chunks, like
+// ExecuteBatch, and returns their outcomes in the order of the plan along
+// with the errors of the chunks that failed, joined.
+func (c *Client) ExecuteBatchPlan(token string, plan *BatchPlan, concurrency int) ([]*BatchOutcome, error) {
+	return c.ExecuteBatchPlanContext(context.Background(), token, plan, concurrency)
+}
+
+// ExecuteBatchPlanContext is like ExecuteBatchPlan but uses ctx for the requests.
+func (c *Client) ExecuteBatchPlanContext(ctx context.Context, token string, plan *BatchPlan, concurrency int) ([]*BatchOutcome, error) {
+	token = c.accessToken(token)
+	for _, br := range plan.requests {
+		br.AccessToken = token
+	}
+	res, err := c.ExecuteBatchContext(ctx, token, plan.requests, concurrency)
+	outcomes := make([]*BatchOutcome, len(plan.requests))
+	for i, br := range plan.requests {
+		outcomes[i] = &BatchOutcome{Request: br, Response: res.Responses[i]}
+	}
+	for _, ce := range res.Errors {
+		for i := ce.Start; i < ce.End; i++ {
+			outcomes[i].Err = ce
+		}
+	}
+	for _, o := range outcomes {
+		switch {
+		case o.Err != nil:
+		case o.Response == nil:
+			o.Err = ErrNoBatchResponse
+		default:
+			o.Err = o.Response.Err()
+		}
+	}
+	return outcomes, err
+}
@@ -0,0 +1,136 @@
+package gosquare_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+)
+
+func TestBatchPlanJSON(t *testing.T) {
+	c := gosquare.NewClient(gosquare.SandboxURL, "")
+	var plan gosquare.BatchPlan
+	plan.Add(
+		c.CreateItemBatchCall("SECRET_TOKEN", "L", &gosquare.CreateItemReqObject{ID: "I1", Name: "Tea"}).BatchRequest,
+		c.RetrievePaymentBatchCall("SECRET_TOKEN", "L", "P000").BatchRequest,
+		c.ListPaymentsWithOptionsBatchCall("SECRET_TOKEN", "L", &gosquare.ListPaymentsOptions{Limit: 10}).BatchRequest,
+		c.DeleteItemBatchCall("SECRET_TOKEN", "L", "I1").BatchRequest,
+	)
+	bts, err := json.Marshal(&plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(bts), "SECRET_TOKEN") {
+		t.Errorf("the plan holds the access token: %s", bts)
+	}
+
+	var reloaded gosquare.BatchPlan
+	if err := json.Unmarshal(bts, &reloaded); err != nil {
+		t.Fatal(err)
+	}
+	want, got := plan.Requests(), reloaded.Requests()
+	if len(got) != len(want) {
+		t.Fatalf("reloaded %d calls, want %d", len(got), len(want))
+	}
+	for i := range want {
+		w, g := want[i], got[i]
+		if g.Operation() != w.Operation() || g.Method != w.Method || g.RelativePath != w.RelativePath ||
+			g.RequestID != w.RequestID || g.AccessToken != "" {
+			t.Errorf("call %d reloaded as %+v, want %+v", i, g, w)
+		}
+	}
+	body, _ := json.Marshal(got[0].Body)
+	if wantBody, _ := json.Marshal(want[0].Body); string(body) != string(wantBody) {
+		t.Errorf("body reloaded as %s, want %s", body, wantBody)
+	}
+
+	plan.Add(&gosquare.BatchRequest{Method: "GET", RelativePath: "/v1/me"})
+	if _, err := json.Marshal(&plan); err == nil {
+		t.Error("a plan with a request of no known operation was marshaled")
+	}
+	if err := json.Unmarshal([]byte(`{"calls":[{"operation":"Nope","method":"GET","relative_path":"/v1/me"}]}`), &reloaded); err == nil {
+		t.Error("a plan with an unknown operation was unmarshaled")
+	}
+}
+
+func TestExecuteBatchPlan(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	seedPayments(srv, "L", 1)
+	srv.SetTokens("NEW_TOKEN")
+	c := srv.Client()
+
+	var plan gosquare.BatchPlan
+	plan.Add(
+		c.CreateItemBatchCall("OLD_TOKEN", "L", &gosquare.CreateItemReqObject{ID: "I1", Name: "Tea"}).BatchRequest,
+		c.RetrievePaymentBatchCall("OLD_TOKEN", "L", "P000").BatchRequest,
+		c.RetrievePaymentBatchCall("OLD_TOKEN", "L", "missing").BatchRequest,
+	)
+	bts, err := json.Marshal(&plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reloaded gosquare.BatchPlan
+	if err := json.Unmarshal(bts, &reloaded); err != nil {
+		t.Fatal(err)
+	}
+	outcomes, err := c.ExecuteBatchPlan("NEW_TOKEN", &reloaded, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqs := reloaded.Requests()
+	if len(outcomes) != len(reqs) {
+		t.Fatalf("got %d outcomes, want %d", len(outcomes), len(reqs))
+	}
+	for i, o := range outcomes {
+		if o.Request != reqs[i] {
+			t.Errorf("outcome %d is for another request", i)
+		}
+	}
+	if o := outcomes[0]; o.Err != nil || o.Response == nil {
+		t.Errorf("CreateItem outcome = %+v", o)
+	}
+	item, err := (&gosquare.BatchCall[*gosquare.Item]{BatchRequest: reqs[0]}).Result()
+	if err != nil || item.ID != "I1" || item.Name != "Tea" {
+		t.Errorf("reloaded CreateItem result = %+v, %v", item, err)
+	}
+	p, err := (&gosquare.BatchCall[*gosquare.Payment]{BatchRequest: reqs[1]}).Result()
+	if err != nil || outcomes[1].Err != nil || p.ID != "P000" {
+		t.Errorf("reloaded RetrievePayment result = %+v, %v, outcome error %v", p, err, outcomes[1].Err)
+	}
+	if !gosquare.IsNotFound(outcomes[2].Err) {
+		t.Errorf("missing payment outcome error = %v, want a 404", outcomes[2].Err)
+	}
+	if _, err := (&gosquare.BatchCall[*gosquare.Item]{BatchRequest: reqs[1]}).Result(); !errors.Is(err, gosquare.ErrBatchResultType) {
+		t.Errorf("a BatchCall of the wrong type got %v, want ErrBatchResultType", err)
+	}
+}
+
+func TestExecuteBatchPlanChunkError(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	seedPayments(srv, "L", 1)
+	srv.InjectFault(gosquaretest.Fault{Path: "/v1/batch", StatusCode: 500, Times: 1})
+	c := srv.Client()
+
+	var plan gosquare.BatchPlan
+	for i := 0; i < 31; i++ {
+		plan.Add(c.RetrievePaymentBatchCall("", "L", "P000").BatchRequest)
+	}
+	outcomes, err := c.ExecuteBatchPlan("", &plan, 1)
+	var ce *gosquare.BatchChunkError
+	if !errors.As(err, &ce) || ce.Start != 0 || ce.End != 30 {
+		t.Fatalf("got error %v, want the first chunk's", err)
+	}
+	for i, o := range outcomes[:30] {
+		if !errors.As(o.Err, &ce) || o.Response != nil {
+			t.Fatalf("outcome %d = %+v, want the chunk error", i, o)
+		}
+	}
+	if o := outcomes[30]; o.Err != nil || o.Response == nil {
+		t.Errorf("outcome of the second chunk = %+v, %v", o, o.Err)
+	}
+}
@@ -0,0 +1,53 @@
+package gosquare
+
+import (
+	"context"
+)
+
+type prefetched[T any] struct {
+	results []T
+	err     error
+}
+
+// prefetch yields the results of the pages fetched by a background
+// goroutine, which runs at most depth pages ahead: depth-1 pages wait in the
+// channel and one more in the goroutine, blocked sending it. depth must be
+// positive.
+func prefetch[T any](ctx context.Context, depth int, first func(ctx context.Context) ([]T, *NextRequest, error), yield func(T, error) bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	pages := make(chan prefetched[T], depth-1)
+	defer func() {
+		// stop the fetcher and wait for it, so it never outlives the iteration
+		cancel()
+		for range pages {
+		}
+	}()
+	go func() {
+		defer close(pages)
+		results, nr, err := first(ctx)
+		for {
+			select {
+			case pages <- prefetched[T]{results, err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil || nr == nil {
+				return
+			}
+			results = nil
+			nr, err = nr.GetNextRequestContext(ctx, &results)
+		}
+	}()
+	for p := range pages {
+		if p.err != nil {
+			var zero T
+			yield(zero, p.err)
+			return
+		}
+		for _, v := range p.results {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package gosquare_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+)
+
+func TestPrefetchDepth(t *testing.T) {
+	const pages = 10
+	for _, depth := range []int{1, 2, 3} {
+		srv := gosquaretest.NewServer()
+		srv.SetPageSize(1)
+		seedPayments(srv, "L", pages)
+		c := srv.Client()
+		c.Prefetch = depth
+		fetched := make(chan struct{}, pages)
+		c.Middleware = append(c.Middleware, func(next gosquare.Handler) gosquare.Handler {
+			return func(ctx context.Context, r *gosquare.Request) (*gosquare.Response, error) {
+				fetched <- struct{}{}
+				return next(ctx, r)
+			}
+		})
+
+		n, consumed := 0, 0
+		for _, err := range c.IterPayments(context.Background(), "", "L", nil) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			consumed++
+			// the fetcher gets depth pages ahead of this one, and no further
+			for ; n < min(consumed+depth, pages); n++ {
+				select {
+				case <-fetched:
+				case <-time.After(5 * time.Second):
+					t.Fatalf("depth %d: only %d pages fetched while page %d is consumed", depth, n, consumed)
+				}
+			}
+			select {
+			case <-fetched:
+				t.Fatalf("depth %d: %d pages fetched while page %d is consumed", depth, n+1, consumed)
+			case <-time.After(10 * time.Millisecond):
+			}
+			if consumed == 5 {
+				break
+			}
+		}
+		srv.Close()
+	}
+}
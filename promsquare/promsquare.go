@@ -0,0 +1,177 @@
+// Package promsquare implements gosquare.Metrics and serves the collected
+// metrics in the Prometheus text exposition format, without depending on
+// the Prometheus client libraries.
+//
+//	m := promsquare.New()
+//	client.Metrics = m
+//	http.Handle("/metrics", m)
+//
+// The exposed metrics are:
+//
+//	gosquare_requests_total{operation, status_class}          counter
+//	gosquare_request_duration_seconds{operation}              histogram
+//	gosquare_retries_total{operation}                         counter
+//	gosquare_pages_total{operation}                           counter
+package promsquare
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the request duration
+// histogram buckets used by New.
+var DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// Metrics collects gosquare metrics and serves them over HTTP.
+// It is safe for concurrent use.
+type Metrics struct {
+	buckets []float64
+
+	mu        sync.Mutex
+	requests  map[[2]string]uint64
+	durations map[string]*histogram
+	retries   map[string]uint64
+	pages     map[string]uint64
+}
+
+type histogram struct {
+	counts []uint64 // cumulative counts are computed when exposed
+	count  uint64
+	sum    float64
+}
+
+// New returns a Metrics using DefaultBuckets.
+func New() *Metrics {
+	return NewWithBuckets(DefaultBuckets)
+}
+
+// NewWithBuckets returns a Metrics whose request duration histogram
+// uses the given bucket upper bounds, in seconds.
+func NewWithBuckets(buckets []float64) *Metrics {
+	b := append([]float64(nil), buckets...)
+	sort.Float64s(b)
+	return &Metrics{
+		buckets:   b,
+		requests:  make(map[[2]string]uint64),
+		durations: make(map[string]*histogram),
+		retries:   make(map[string]uint64),
+		pages:     make(map[string]uint64),
+	}
+}
+
+// ObserveRequest implements gosquare.Metrics.
+func (m *Metrics) ObserveRequest(operation, statusClass string, latency time.Duration) {
+	secs := latency.Seconds()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[[2]string{operation, statusClass}]++
+	h, ok := m.durations[operation]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(m.buckets))}
+		m.durations[operation] = h
+	}
+	for i, le := range m.buckets {
+		if secs <= le {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += secs
+}
+
+// IncRetries implements gosquare.Metrics.
+func (m *Metrics) IncRetries(operation string) {
+	m.mu.Lock()
+	m.retries[operation]++
+	m.mu.Unlock()
+}
+
+// IncPages implements gosquare.Metrics.
+func (m *Metrics) IncPages(operation string) {
+	m.mu.Lock()
+	m.pages[operation]++
+	m.mu.Unlock()
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteText(w)
+}
+
+// WriteText writes the metrics to out in the Prometheus text exposition format.
+func (m *Metrics) WriteText(out io.Writer) error {
+	w := bufio.NewWriter(out)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fmt.Fprintln(w, "# HELP gosquare_requests_total Square API calls by operation and status class.")
+	fmt.Fprintln(w, "# TYPE gosquare_requests_total counter")
+	keys := make([][2]string, 0, len(m.requests))
+	for k := range m.requests {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+	for _, k := range keys {
+		fmt.Fprintf(w, "gosquare_requests_total{operation=%s,status_class=%s} %d\n", quote(k[0]), quote(k[1]), m.requests[k])
+	}
+
+	fmt.Fprintln(w, "# HELP gosquare_request_duration_seconds Latency of Square API calls, retries included.")
+	fmt.Fprintln(w, "# TYPE gosquare_request_duration_seconds histogram")
+	for _, op := range sortedKeys(m.durations) {
+		h := m.durations[op]
+		var cum uint64
+		for i, le := range m.buckets {
+			cum += h.counts[i]
+			fmt.Fprintf(w, "gosquare_request_duration_seconds_bucket{operation=%s,le=\"%s\"} %d\n", quote(op), formatFloat(le), cum)
+		}
+		fmt.Fprintf(w, "gosquare_request_duration_seconds_bucket{operation=%s,le=\"+Inf\"} %d\n", quote(op), h.count)
+		fmt.Fprintf(w, "gosquare_request_duration_seconds_sum{operation=%s} %s\n", quote(op), formatFloat(h.sum))
+		fmt.Fprintf(w, "gosquare_request_duration_seconds_count{operation=%s} %d\n", quote(op), h.count)
+	}
+
+	writeCounter(w, "gosquare_retries_total", "Retried Square API calls by operation.", m.retries)
+	writeCounter(w, "gosquare_pages_total", "Pages fetched through NextRequest by operation.", m.pages)
+	return w.Flush()
+}
+
+func writeCounter(w *bufio.Writer, name, help string, values map[string]uint64) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s counter\n", name)
+	for _, op := range sortedKeys(values) {
+		fmt.Fprintf(w, "%s{operation=%s} %d\n", name, quote(op), values[op])
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// quote quotes a label value as the exposition format requires.
+func quote(v string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(v) + `"`
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,51 @@
+package promsquare
+
+import (
+	"bytes"
+	"flag"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+func TestWriteText(t *testing.T) {
+	m := NewWithBuckets([]float64{1, 0.25, 0.5})
+	m.ObserveRequest("ListPayments", "2xx", 125*time.Millisecond)
+	m.ObserveRequest("ListPayments", "2xx", 250*time.Millisecond)
+	m.ObserveRequest("ListPayments", "5xx", 2*time.Second)
+	m.ObserveRequest("RetrieveItem", "4xx", 500*time.Millisecond)
+	m.ObserveRequest("Odd\"op\\name\nline", "error", 0)
+	m.IncRetries("ListPayments")
+	m.IncRetries("ListPayments")
+	m.IncPages("ListPayments")
+
+	var buf bytes.Buffer
+	if err := m.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	const golden = "testdata/metrics.txt"
+	if *update {
+		if err := os.WriteFile(golden, buf.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != string(want) {
+		t.Errorf("WriteText wrote:\n%s\nwant:\n%s", got, want)
+	}
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if ct := rec.Header().Get("Content-Type"); ct != "text/plain; version=0.0.4; charset=utf-8" {
+		t.Errorf("served Content-Type %q", ct)
+	}
+	if rec.Body.String() != string(want) {
+		t.Errorf("served:\n%s\nwant:\n%s", rec.Body, want)
+	}
+}
@@ -0,0 +1,138 @@
+package gosquare
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// query builds the query string of a request. Values are escaped, and the
+// parameters set with set and setInt are left out when they are zero, so
+// unset filters are never sent to Square.
+type query url.Values
+
+// set sets key to value, unless value is empty.
+func (q query) set(key, value string) query {
+	if len(value) > 0 {
+		url.Values(q).Set(key, value)
+	}
+	return q
+}
+
+// setInt sets key to value, unless value is 0.
+func (q query) setInt(key string, value int) query {
+	if value != 0 {
+		url.Values(q).Set(key, strconv.Itoa(value))
+	}
+	return q
+}
+
+// add sets key to value, even if it is the zero value,
+// for the parameters whose zero value means something.
+func (q query) add(key string, value interface{}) query {
+	url.Values(q).Set(key, fmt.Sprint(value))
+	return q
+}
+
+// path returns p followed by the query string, if any.
+// Parameters are sorted by key.
+func (q query) path(p string) string {
+	if len(q) == 0 {
+		return p
+	}
+	return p + "?" + url.Values(q).Encode()
+}
+
+// The paths of the endpoints taking a query string, shared by the
+// endpoint methods and their BatchRequest twins.
+
+func listEmployeesPath(order, beginUpdatedAt, endUpdatedAt, beginCreatedAt, endCreatedAt, status, externalID string, limit int) string {
+	return query{}.
+		set("order", order).
+		set("begin_updated_at", beginUpdatedAt).
+		set("end_updated_at", endUpdatedAt).
+		set("begin_created_at", beginCreatedAt).
+		set("end_created_at", endCreatedAt).
+		set("status", status).
+		set("external_id", externalID).
+		setInt("limit", limit).
+		path("/v1/me/employees")
+}
+
+func listRolesPath(order string, limit int) string {
+	return query{}.set("order", order).setInt("limit", limit).path("/v1/me/roles")
+}
+
+// listTimecardsPath sends deleted unless it is nil, Square only
+// leaves deleted timecards out when it is false.
+func listTimecardsPath(order, employeeID, beginClockinTime, endClockinTime, beginClockoutTime, endClockoutTime, beginUpdatedAt, endUpdatedAt string, deleted *bool, limit int) string {
+	q := query{}.
+		set("order", order).
+		set("employee_id", employeeID).
+		set("begin_clockin_time", beginClockinTime).
+		set("end_clockin_time", endClockinTime).
+		set("begin_clockout_time", beginClockoutTime).
+		set("end_clockout_time", endClockoutTime).
+		set("begin_updated_at", beginUpdatedAt).
+		set("end_updated_at", endUpdatedAt)
+	if deleted != nil {
+		q.add("deleted", *deleted)
+	}
+	return q.setInt("limit", limit).path("/v1/me/timecards")
+}
+
+func listCashDrawerShiftsPath(locationID, beginTime, endTime, order string) string {
+	return query{}.
+		set("begin_time", beginTime).
+		set("end_time", endTime).
+		set("order", order).
+		path(fmt.Sprintf("/v1/%s/cash-drawer-shifts", locationID))
+}
+
+func listPaymentsPath(locationID, beginTime, endTime, order string, limit int) string {
+	return query{}.
+		set("begin_time", beginTime).
+		set("end_time", endTime).
+		set("order", order).
+		setInt("limit", limit).
+		path(fmt.Sprintf("/v1/%s/payments", locationID))
+}
+
+func listSettlementsPath(locationID, beginTime, endTime, order string, limit int, status string) string {
+	return query{}.
+		set("begin_time", beginTime).
+		set("end_time", endTime).
+		set("order", order).
+		setInt("limit", limit).
+		set("status", status).
+		path(fmt.Sprintf("/v1/%s/settlements", locationID))
+}
+
+func listRefundsPath(locationID, beginTime, endTime, order string, limit int) string {
+	return query{}.
+		set("begin_time", beginTime).
+		set("end_time", endTime).
+		set("order", order).
+		setInt("limit", limit).
+		path(fmt.Sprintf("/v1/%s/refunds", locationID))
+}
+
+func listOrdersPath(locationID string, limit int, order string) string {
+	return query{}.setInt("limit", limit).set("order", order).path(fmt.Sprintf("/v1/%s/orders", locationID))
+}
+
+func listInventoryPath(locationID string, limit int) string {
+	return query{}.setInt("limit", limit).path(fmt.Sprintf("/v1/%s/inventory", locationID))
+}
+
+// deleteCellPath always sends row and column, 0 being the first of each.
+func deleteCellPath(locationID, pageID string, row, column int) string {
+	return query{}.add("row", row).add("column", column).path(fmt.Sprintf("/v1/%s/pages/%s/cells", locationID, pageID))
+}
+
+func listSubscriptionsPath(clientID, merchantID string, limit int) string {
+	return query{}.
+		set("merchant_id", merchantID).
+		setInt("limit", limit).
+		path(fmt.Sprintf("/oauth2/clients/%s/subscriptions", clientID))
+}
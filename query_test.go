@@ -0,0 +1,124 @@
+package gosquare
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestQueryPaths(t *testing.T) {
+	yes, no := true, false
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"no filters", listPaymentsPath("L", "", "", "", 0), "/v1/L/payments"},
+		{
+			"timestamp offset",
+			listPaymentsPath("L", "2026-01-01T00:00:00+02:00", "2026-01-02T00:00:00-05:00", "DESC", 50),
+			"/v1/L/payments?begin_time=2026-01-01T00%3A00%3A00%2B02%3A00&end_time=2026-01-02T00%3A00%3A00-05%3A00&limit=50&order=DESC",
+		},
+		{"escaped value", listEmployeesPath("", "", "", "", "", "ACTIVE", "a&b c", 0), "/v1/me/employees?external_id=a%26b+c&status=ACTIVE"},
+		{"zero limit", listRolesPath("ASC", 0), "/v1/me/roles?order=ASC"},
+		{"deleted unset", listTimecardsPath("", "E1", "", "", "", "", "", "", nil, 0), "/v1/me/timecards?employee_id=E1"},
+		{"deleted false", listTimecardsPath("", "", "", "", "", "", "", "", &no, 0), "/v1/me/timecards?deleted=false"},
+		{"deleted true", listTimecardsPath("", "", "", "", "", "", "", "", &yes, 10), "/v1/me/timecards?deleted=true&limit=10"},
+		{"first cell", deleteCellPath("L", "PG", 0, 0), "/v1/L/pages/PG/cells?column=0&row=0"},
+		{"cell", deleteCellPath("L", "PG", 2, 3), "/v1/L/pages/PG/cells?column=3&row=2"},
+		{"subscriptions", listSubscriptionsPath("APP", "", 0), "/oauth2/clients/APP/subscriptions"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+// TestQueryPathsBatch checks that the endpoint methods and their
+// BatchRequest twins request the same paths.
+func TestQueryPathsBatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "[]")
+	}))
+	defer srv.Close()
+	c := NewClient(srv.URL, "TOKEN")
+	var path string
+	c.Middleware = append(c.Middleware, func(next Handler) Handler {
+		return func(ctx context.Context, r *Request) (*Response, error) {
+			path = r.Path
+			return next(ctx, r)
+		}
+	})
+
+	begin := time.Date(2026, 1, 1, 0, 0, 0, 0, time.FixedZone("", 2*60*60))
+	no := false
+	tests := []struct {
+		name  string
+		call  func() error
+		batch func() (*BatchRequest, string)
+	}{
+		{
+			"ListPayments",
+			func() error {
+				_, _, err := c.ListPayments("", "L", "2026-01-01T00:00:00+02:00", "", "DESC", 5)
+				return err
+			},
+			func() (*BatchRequest, string) {
+				return c.ListPaymentsBatchRequest("", "L", "2026-01-01T00:00:00+02:00", "", "DESC", 5)
+			},
+		},
+		{
+			"ListPaymentsWithOptions",
+			func() error {
+				_, _, err := c.ListPaymentsWithOptions("", "L", &ListPaymentsOptions{BeginTime: begin, Order: SortDesc})
+				return err
+			},
+			func() (*BatchRequest, string) {
+				return c.ListPaymentsWithOptionsBatchRequest("", "L", &ListPaymentsOptions{BeginTime: begin, Order: SortDesc})
+			},
+		},
+		{
+			"ListTimecards",
+			func() error { _, _, err := c.ListTimecards("", "", "E1", "", "", "", "", "", "", false, 0); return err },
+			func() (*BatchRequest, string) {
+				return c.ListTimecardsBatchRequest("", "", "E1", "", "", "", "", "", "", false, 0)
+			},
+		},
+		{
+			"ListTimecardsWithOptions",
+			func() error {
+				_, _, err := c.ListTimecardsWithOptions("", &ListTimecardsOptions{Deleted: &no})
+				return err
+			},
+			func() (*BatchRequest, string) {
+				return c.ListTimecardsWithOptionsBatchRequest("", &ListTimecardsOptions{Deleted: &no})
+			},
+		},
+		{
+			"ListEmployees",
+			func() error { _, _, err := c.ListEmployees("", "", "", "", "", "", "", "a&b c", 0); return err },
+			func() (*BatchRequest, string) {
+				return c.ListEmployeesBatchRequest("", "", "", "", "", "", "", "a&b c", 0)
+			},
+		},
+		{
+			"DeleteCell",
+			func() error { return c.DeleteCell("", "L", "PG", 0, 0) },
+			func() (*BatchRequest, string) { return c.DeleteCellBatchRequest("", "L", "PG", 0, 0) },
+		},
+	}
+	for _, tt := range tests {
+		path = ""
+		if err := tt.call(); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if br, _ := tt.batch(); br.RelativePath != path {
+			t.Errorf("%s: the BatchRequest path is %s, the method's %s", tt.name, br.RelativePath, path)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package gosquare
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned instead of making a request when a RateLimiter
+// configured with FailFast has no capacity left for the request's access token,
+// or when the wait for capacity would outlast the call's context.
+var ErrRateLimited = errors.New("gosquare: client-side rate limit exceeded")
+
+// ErrExceedsBurst is returned instead of making a request when a RateLimiter
+// configured with FailFast is asked for more requests against one key at once
+// than its Burst, for example by a SubmitBatch with more batched requests for
+// one access token. A bucket never holds more than Burst, so the request
+// could never be made, retrying it is pointless: split the batch or raise Burst.
+var ErrExceedsBurst = errors.New("gosquare: request needs more client-side rate limit capacity than the limiter's burst")
+
+// The number of buckets kept before idle, full buckets are dropped.
+const _MaxIdleBuckets = 1024
+
+// RateLimiter is a client-side token bucket limiter, keyed by access token
+// and optionally by endpoint family (payments, items, employees, ...).
+// Set it as Client.Limiter to have it applied to every request the Client
+// makes, including retries, NextRequest pages and SubmitBatch, where each
+// batched request counts against its own access token.
+//
+// A RateLimiter may be shared by several Clients and is safe for concurrent use.
+// Its exported fields must not be changed once it is in use.
+type RateLimiter struct {
+	// Rate is the number of requests allowed per second for each key.
+	Rate float64
+	// Burst is the number of requests that can be made at once for each key
+	// after a period of inactivity. Values below 1 are treated as 1.
+	Burst int
+	// PerEndpointFamily, if true, gives every endpoint family its own bucket
+	// for each access token, instead of one bucket per access token.
+	PerEndpointFamily bool
+	// FailFast, if true, makes requests without available capacity fail with
+	// ErrRateLimited instead of waiting for it. Requests needing more than
+	// Burst at once for one key fail with ErrExceedsBurst. Without FailFast,
+	// such requests wait until the bucket is full and leave it in debt, so
+	// the requests after them wait for the excess to be refilled as well.
+	FailFast bool
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter allowing rate requests per second,
+// with bursts of up to burst requests, for each access token.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		Rate:  rate,
+		Burst: burst,
+	}
+}
+
+// wait blocks until the limiter has capacity for r, every request of
+// a batch is counted against the key of the batched request.
+func (l *RateLimiter) wait(ctx context.Context, r *Request) error {
+	if l == nil || l.Rate <= 0 {
+		return nil
+	}
+	counts := make(map[string]int)
+	if r.Batch != nil {
+		for _, br := range r.Batch {
+			counts[l.key(br.AccessToken, br.RelativePath)]++
+		}
+	} else {
+		counts[l.key(r.Token, r.Path)]++
+	}
+	d, err := l.reserve(ctx, counts, time.Now())
+	if err != nil || d <= 0 {
+		return err
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		l.release(counts)
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func (l *RateLimiter) key(token, path string) string {
+	if l.PerEndpointFamily {
+		return token + " " + endpointFamily(path)
+	}
+	return token
+}
+
+// reserve takes n tokens out of every bucket in counts and returns how long
+// the caller has to wait for the most depleted of them to refill.
+// Nothing is taken if the request can't be made.
+// A bucket may go below zero, when n is larger than the burst.
+func (l *RateLimiter) reserve(ctx context.Context, counts map[string]int, now time.Time) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.buckets == nil {
+		l.buckets = make(map[string]*bucket)
+	}
+	burst := float64(l.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	var wait time.Duration
+	for k, n := range counts {
+		if l.FailFast && float64(n) > burst {
+			return 0, ErrExceedsBurst
+		}
+		b, ok := l.buckets[k]
+		if !ok {
+			b = &bucket{tokens: burst, last: now}
+		}
+		tokens := b.tokens + now.Sub(b.last).Seconds()*l.Rate
+		if tokens > burst {
+			tokens = burst
+		}
+		if deficit := float64(n) - tokens; deficit > 0 {
+			if d := time.Duration(deficit / l.Rate * float64(time.Second)); d > wait {
+				wait = d
+			}
+		}
+	}
+	if wait > 0 {
+		if l.FailFast {
+			return 0, ErrRateLimited
+		}
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(wait)) {
+			return 0, ErrRateLimited
+		}
+	}
+	if len(l.buckets) > _MaxIdleBuckets {
+		l.prune(now, burst)
+	}
+	for k, n := range counts {
+		b, ok := l.buckets[k]
+		if !ok {
+			b = &bucket{tokens: burst, last: now}
+			l.buckets[k] = b
+		}
+		b.tokens += now.Sub(b.last).Seconds() * l.Rate
+		if b.tokens > burst {
+			b.tokens = burst
+		}
+		b.tokens -= float64(n)
+		b.last = now
+	}
+	return wait, nil
+}
+
+// release gives back tokens taken by reserve for a request that was never made.
+func (l *RateLimiter) release(counts map[string]int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for k, n := range counts {
+		if b, ok := l.buckets[k]; ok {
+			b.tokens += float64(n)
+		}
+	}
+}
+
+// prune drops the buckets that have refilled completely,
+// they are indistinguishable from new ones.
+func (l *RateLimiter) prune(now time.Time, burst float64) {
+	for k, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.Rate >= burst {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// endpointFamily returns the resource an endpoint path belongs to,
+// for example "payments" for "/v1/LOCATION_ID/payments/PAYMENT_ID".
+func endpointFamily(path string) string {
+	if i := strings.IndexByte(path, '?'); i > -1 {
+		path = path[:i]
+	}
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(segs) >= 3 && segs[0] == "v1":
+		return segs[2]
+	case len(segs) == 2 && segs[0] == "v1":
+		return segs[1]
+	case len(segs) >= 4 && segs[0] == "oauth2" && segs[1] == "clients":
+		return segs[3]
+	}
+	return segs[0]
+}
@@ -0,0 +1,33 @@
+package gosquare_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+)
+
+func TestRateLimiterBatchOverBurst(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	seedPayments(srv, "L", 3)
+	c := srv.Client()
+	reqs := make([]*gosquare.BatchRequest, 3)
+	for i := range reqs {
+		reqs[i], _ = c.RetrievePaymentBatchRequest("", "L", "P000")
+	}
+
+	c.Limiter = &gosquare.RateLimiter{Rate: 1000, Burst: 2, FailFast: true}
+	if _, err := c.SubmitBatch("", reqs); !errors.Is(err, gosquare.ErrExceedsBurst) {
+		t.Fatalf("got error %v, want ErrExceedsBurst", err)
+	}
+	if _, err := c.SubmitBatch("", reqs[:2]); err != nil {
+		t.Fatalf("a batch within the burst failed: %v", err)
+	}
+
+	c.Limiter = &gosquare.RateLimiter{Rate: 1000, Burst: 2}
+	if _, err := c.SubmitBatch("", reqs); err != nil {
+		t.Fatalf("a waiting limiter failed a batch over the burst: %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package gosquare
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha1"
@@ -14,24 +15,52 @@ import (
 )
 
 const (
-	_SquareEndpoint = "https://connect.squareup.com"
-	_OAuthPerm      = _SquareEndpoint + "/oauth2/authorize?client_id=%s&scope=%s&session=%t"
+	_OAuthPerm = "/oauth2/authorize?client_id=%s&scope=%s&session=%t"
 )
 
 type NextRequest struct {
-	uri   string
-	token string
+	// the operation that returned the first page
+	operation string
+	uri       string
+	token     string
+	client    *Client
+	// the number of this page, the first follow-up being 1
+	page int
+	// ctx is the context of the call that returned this NextRequest,
+	// so cancelling it also stops any page walk that follows.
+	ctx context.Context
 }
 
 func (nr *NextRequest) GetNextRequest(result interface{}) (*NextRequest, error) {
-	return squareRequest("GET", nr.uri, nr.token, nil, result)
+	ctx := nr.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return nr.GetNextRequestContext(ctx, result)
+}
+
+// GetNextRequestContext is like GetNextRequest but uses ctx for the request
+// and for any NextRequest it returns.
+func (nr *NextRequest) GetNextRequestContext(ctx context.Context, result interface{}) (*NextRequest, error) {
+	return nr.client.baseSquareRequest(ctx, &Request{
+		Operation: nr.operation,
+		Method:    "GET",
+		Path:      nr.uri,
+		Token:     nr.token,
+		Result:    result,
+		Page:      nr.page,
+	})
 }
 
 func (nr *NextRequest) GetNextRequestAsBatchRequest(result interface{}) (*BatchRequest, string) {
-	return newBatchRequest("GET", nr.uri, nr.token, nil, result)
+	return newBatchRequest(nr.operation, "GET", nr.uri, nr.token, nil, result)
+}
+
+func (c *Client) newBatchRequest(operation, method, action, token string, reqObj, result interface{}) (*BatchRequest, string) {
+	return newBatchRequest(operation, method, action, c.accessToken(token), reqObj, result)
 }
 
-func newBatchRequest(method, action, token string, reqObj, result interface{}) (*BatchRequest, string) {
+func newBatchRequest(operation, method, action, token string, reqObj, result interface{}) (*BatchRequest, string) {
 	reqID := newUUID()
 	return &BatchRequest{
 		Method:       method,
@@ -39,6 +68,7 @@ func newBatchRequest(method, action, token string, reqObj, result interface{}) (
 		AccessToken:  token,
 		Body:         reqObj,
 		RequestID:    reqID,
+		operation:    operation,
 		result:       result,
 	}, reqID
 }
@@ -50,60 +80,133 @@ func newUUID() string {
 	}
 	uuid[6] = (uuid[6] & 0x0f) | 0x40
 	uuid[8] = (uuid[8] & 0x3f) | 0x80
-	return string(uuid)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:])
 }
 
-func squareRequest(method, action, token string, reqObj, result interface{}) (*NextRequest, error) {
-	var body io.Reader = nil
+func (c *Client) squareRequest(ctx context.Context, operation, method, action, token string, reqObj, result interface{}) (*NextRequest, error) {
+	if c.Coalescer != nil && coalescable(operation, method, action) {
+		return nil, c.Coalescer.do(ctx, c, operation, action, token, result)
+	}
+	var body []byte
 	if reqObj != nil {
 		bts, err := json.Marshal(reqObj)
 		if err != nil {
 			return nil, err
 		}
-		body = bytes.NewReader(bts)
+		body = bts
+	}
+	return c.baseSquareRequest(ctx, &Request{
+		Operation:   operation,
+		Method:      method,
+		Path:        action,
+		Token:       token,
+		ContentType: "application/json",
+		Body:        body,
+		Result:      result,
+	})
+}
+
+func (c *Client) baseSquareRequest(ctx context.Context, r *Request) (*NextRequest, error) {
+	if !isOAuth(r.Path) {
+		r.Token = c.accessToken(r.Token)
+	}
+	resp, err := c.handler()(ctx, r)
+	if err != nil {
+		return nil, err
 	}
-	return baseSquareRequest(method, action, token, "application/json", body, result)
+	return resp.NextRequest, nil
+}
+
+// send is the innermost Handler, it makes the request,
+// retrying and throttling it as configured.
+func (c *Client) send(ctx context.Context, r *Request) (*Response, error) {
+	return c.retry(ctx, r, func() (*Response, error) {
+		if err := c.Limiter.wait(ctx, r); err != nil {
+			return nil, err
+		}
+		return c.sendRequest(ctx, r)
+	})
 }
 
-func baseSquareRequest(method, action, token, contentType string, body io.Reader, result interface{}) (*NextRequest, error) {
-	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", _SquareEndpoint, action), body)
+func isOAuth(path string) bool {
+	return strings.Index(path, "oauth2") > -1
+}
+
+// sendRequest makes a single attempt at a request.
+func (c *Client) sendRequest(ctx context.Context, r *Request) (*Response, error) {
+	// the next page request inherits the caller's context, not the timeout
+	pageCtx := ctx
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+	var body io.Reader
+	if r.Body != nil {
+		body = bytes.NewReader(r.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.Method, fmt.Sprintf("%s%s", c.baseURL(), r.Path), body)
 	if err != nil {
 		return nil, err
 	}
 	var p1Auth string
-	if strings.Index(action, "oauth2") > -1 {
+	if isOAuth(r.Path) {
 		p1Auth = "Client"
 	} else {
 		p1Auth = "Bearer"
 	}
-	req.Header["Authorization"] = []string{fmt.Sprintf("%s %s", p1Auth, token)}
+	req.Header["Authorization"] = []string{fmt.Sprintf("%s %s", p1Auth, r.Token)}
 	req.Header["Accept"] = []string{"application/json"}
-	if method == "POST" || method == "PUT" {
-		req.Header.Set("Content-Type", contentType)
+	if len(c.UserAgent) > 0 {
+		req.Header.Set("User-Agent", c.UserAgent)
 	}
-	resp, err := http.DefaultClient.Do(req)
+	if r.Method == "POST" || r.Method == "PUT" {
+		req.Header.Set("Content-Type", r.ContentType)
+	}
+	for k, v := range r.Header {
+		req.Header[k] = v
+	}
+	resp, err := c.httpClient().Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
-	var nr *NextRequest = nil
-	if method != "DELETE" {
-		if v, ok := resp.Header["Link"]; ok && len(v) > 0 {
-			nr = newNextRequest(v[0], token)
+	if !isSuccess(resp.StatusCode) {
+		return nil, newAPIError(r.Method, r.Path, resp)
+	}
+	res := &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Result:     r.Result,
+	}
+	if r.Method != "DELETE" {
+		if v := resp.Header.Values("Link"); len(v) > 0 {
+			res.NextRequest, err = c.newNextRequest(pageCtx, r.Operation, strings.Join(v, ", "), r.Token, r.Page+1)
+			if err != nil {
+				return nil, err
+			}
 		}
 		dec := json.NewDecoder(resp.Body)
-		if err = dec.Decode(result); err != nil {
+		if sd, ok := r.Result.(streamDecoder); ok {
+			err = sd.decodeStream(dec)
+		} else {
+			err = dec.Decode(r.Result)
+		}
+		if err != nil {
 			return nil, err
 		}
 	}
-	return nr, nil
+	return res, nil
 }
 
-func newNextRequest(linkHeader, token string) *NextRequest {
-	s := strings.Split(linkHeader, ";")[0]
-	// 29 is the length of "<https://connect.squareup.com"
-	n := s[29 : len(s)-1]
-	return &NextRequest{n, token}
+// newNextRequest returns the NextRequest for the rel="next" link of a Link header,
+// nil if it has none.
+func (c *Client) newNextRequest(ctx context.Context, operation, linkHeader, token string, page int) (*NextRequest, error) {
+	uri, err := c.nextPath(linkHeader)
+	if err != nil || len(uri) == 0 {
+		return nil, err
+	}
+	return &NextRequest{operation: operation, uri: uri, token: token, client: c, page: page, ctx: ctx}, nil
 }
 
 // Generate a url to pass to a user to gain permisson to their account.
@@ -115,8 +218,8 @@ func newNextRequest(linkHeader, token string) *NextRequest {
 // Scope should be a space seperated list of permissions, see the above url
 // for details on what permissions are available.
 // This function will escape all your arguments so don't pass uri-escaped values.
-func GeneratePermissionURL(clientID, scope string, session bool, locale, state string) string {
-	uri := fmt.Sprintf(_OAuthPerm, url.QueryEscape(clientID), url.QueryEscape(scope), session)
+func (c *Client) GeneratePermissionURL(clientID, scope string, session bool, locale, state string) string {
+	uri := c.baseURL() + fmt.Sprintf(_OAuthPerm, url.QueryEscape(clientID), url.QueryEscape(scope), session)
 	if len(locale) > 0 {
 		uri += fmt.Sprintf("&locale=%s", url.QueryEscape(locale))
 	}
@@ -135,26 +238,36 @@ type Token struct {
 }
 
 // Get first token from new merchant's authorization code.
-func GetToken(authorizationCode, applicationID, applicationSecret string) (*Token, error) {
+func (c *Client) GetToken(authorizationCode, applicationID, applicationSecret string) (*Token, error) {
+	return c.GetTokenContext(context.Background(), authorizationCode, applicationID, applicationSecret)
+}
+
+// GetTokenContext is like GetToken but uses ctx for the request.
+func (c *Client) GetTokenContext(ctx context.Context, authorizationCode, applicationID, applicationSecret string) (*Token, error) {
 	reqObj := map[string]string{
 		"code":          authorizationCode,
 		"client_id":     applicationID,
 		"client_secret": applicationSecret,
 	}
 	t := new(Token)
-	if _, err := squareRequest("POST", "/oauth2/token", applicationSecret, &reqObj, t); err != nil {
+	if _, err := c.squareRequest(ctx, "GetToken", "POST", "/oauth2/token", applicationSecret, &reqObj, t); err != nil {
 		return nil, err
 	}
 	return t, nil
 }
 
 // Renew token from expired token. If the token is older than 30 days this won't work.
-func RenewToken(expiredToken, applicationID, applicationSecret string) (*Token, error) {
+func (c *Client) RenewToken(expiredToken, applicationID, applicationSecret string) (*Token, error) {
+	return c.RenewTokenContext(context.Background(), expiredToken, applicationID, applicationSecret)
+}
+
+// RenewTokenContext is like RenewToken but uses ctx for the request.
+func (c *Client) RenewTokenContext(ctx context.Context, expiredToken, applicationID, applicationSecret string) (*Token, error) {
 	reqObj := map[string]string{
 		"access_token": expiredToken,
 	}
 	t := new(Token)
-	if _, err := squareRequest("POST",
+	if _, err := c.squareRequest(ctx, "RenewToken", "POST",
 		fmt.Sprintf("/oauth2/clients/%s/access-token/renew", applicationID),
 		applicationSecret, &reqObj, t); err != nil {
 		return nil, err
@@ -0,0 +1,443 @@
+package gosquare
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreateEmployeeReqObject struct {
+	// The employee's first name.
+	FirstName string `json:"first_name"`
+	// The employee's last name.
+	LastName string `json:"last_name"`
+	// An optional second ID to associate the employee with an entity in another system.
+	ExternalID string `json:"external_id"`
+	// The ids of the employee's associated roles. Currently, you can specify only one
+	// or zero roles per employee.Default value: []
+	RoleIDs []string `json:"role_ids"`
+	// An optional email address to associate with the employee.Note that you cannot edit an existing employee's email address with the Connect API.
+	// You can only set its initial value when creating an employee.
+	Email string `json:"email"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdateEmployeeReqObject struct {
+	// The employee's first name.
+	FirstName string `json:"first_name"`
+	// The employee's last name.
+	LastName string `json:"last_name"`
+	// An optional second ID to associate the employee with an entity in another system.
+	ExternalID string `json:"external_id"`
+	// The employee's associated roles. Currently, you can specify only one or zero roles per
+	// employee.
+	RoleIDs []string `json:"role_ids"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreateRoleReqObject struct {
+	// The role's name.
+	Name string `json:"name"`
+	// The role's permissions.
+	Permissions []string `json:"permissions"`
+	// If true, employees with this role have all permissions, regardless of the
+	// values indicated in permissions.Default value: false
+	IsOwner bool `json:"is_owner"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdateRoleReqObject struct {
+	// The role's name.
+	Name string `json:"name"`
+	// The role's permissions.
+	Permissions []string `json:"permissions"`
+	// If true, employees with this role have all permissions, regardless of the
+	// values indicated in permissions.
+	IsOwner bool `json:"is_owner"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreateTimecardReqObject struct {
+	// The employee to create a timecard for.
+	EmployeeID string `json:"employee_id"`
+	// The clock-in time for the timecard, in ISO 8601 format.Default value: The current time.
+	ClockinTime string `json:"clockin_time"`
+	// The clock-out time for the timecard, in ISO 8601 format.
+	// Provide this value only if importing timecard information from another system.
+	ClockoutTime string `json:"clockout_time"`
+	// The ID of the location the employee clocked in from, if any.
+	ClockinLocationID string `json:"clockin_location_id"`
+	// The ID of the location the employee clocked out from. Provide this value only if
+	// importing timecard information from another system.If you provide this value, you must also provide a value for clockout_time.
+	ClockoutLocationID string `json:"clockout_location_id"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdateTimecardReqObject struct {
+	// The clock-in time for the timecard, in ISO 8601 format.
+	ClockinTime string `json:"clockin_time"`
+	// The clock-out time for the timecard, in ISO 8601 format.
+	ClockoutTime string `json:"clockout_time"`
+	// The ID of the location the employee clocked in from, if any.
+	ClockinLocationID string `json:"clockin_location_id"`
+	// The ID of the location the employee clocked out from, if any.
+	ClockoutLocationID string `json:"clockout_location_id"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreateRefundReqObject struct {
+	// The ID of the payment to refund.If you're creating a PARTIAL refund for a split tender payment, instead provide
+	// the id of the particular tender you want to refund. See Split Tender Payments for details.
+	PaymentID string `json:"payment_id"`
+	// The type of refund (FULL or PARTIAL).
+	Type string `json:"type"`
+	// The reason for the refund.
+	Reason string `json:"reason"`
+	// The amount of money to refund. Required only for PARTIAL refunds.The value of amount must be negative.
+	// It is left out of the request body for FULL refunds.
+	RefundedMoney Money `json:"refunded_money,omitzero"`
+	// An optional key to ensure idempotence if you issue the same PARTIAL refund
+	// request more than once.If you attempt to issue a partial refund and you aren't sure whether your request
+	// succeeded, you can safely repeat your request with the same
+	// request_idempotence_key. If you want to issue another partial refund for
+	// the same payment, you must use a request_idempotence_key that is unique among
+	// refunds you have issued for the payment.
+	RequestIDempotenceKey string `json:"request_idempotence_key,omitempty"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+//
+// The tracking number and the notes are left out when empty, Square only
+// accepts those matching the action.
+type UpdateOrderReqObject struct {
+	// The action to perform on the order (COMPLETE, CANCEL, or
+	// REFUND).
+	Action string `json:"action"`
+	// The tracking number of the shipment associated with the order. Only valid if
+	// action is COMPLETE.
+	ShippedTrackingNumber string `json:"shipped_tracking_number,omitempty"`
+	// A merchant-specified note about the completion of the order. Only valid if
+	// action is COMPLETE.
+	CompletedNote string `json:"completed_note,omitempty"`
+	// A merchant-specified note about the refunding of the order. Only valid if action
+	// is REFUND.
+	RefundedNote string `json:"refunded_note,omitempty"`
+	// A merchant-specified note about the canceling of the order. Only valid if action
+	// is CANCEL.
+	CanceledNote string `json:"canceled_note,omitempty"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreateItemReqObject struct {
+	// The item's ID. Must be unique among all entity IDs ever provided on behalf of the
+	// merchant. You can never reuse an ID. This value can include alphanumeric
+	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
+	ID string `json:"id"`
+	// The item's name.
+	Name string `json:"name"`
+	// The item's description.
+	Description string `json:"description"`
+	// The ID of the item's category, if any.
+	CategoryID string `json:"category_id"`
+	// The color of the item's display label in Square Register.Default value: 9da2a6
+	Color string `json:"color"`
+	// The text of the item's display label in Square Register. Only up to the first five
+	// characters of the string are used.Default value: The first two characters of the item's name.
+	Abbreviation string `json:"abbreviation"`
+	// Indicates whether the item is viewable from the merchant's online store (PUBLIC)
+	// or PRIVATE.Default value: PUBLIC
+	Visibility string `json:"visibility"`
+	// If true, the item can be added to shipping orders from the merchant's online
+	// store.Default value: false
+	AvailableOnline bool `json:"available_online"`
+	// If true, the item can be added to pickup orders from the merchant's online
+	// store.Default value: false
+	AvailableForPickup bool `json:"available_for_pickup"`
+	// The item's variations. You must specify at least one variation.
+	Variations []ItemVariation `json:"variations"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdateItemReqObject struct {
+	// The item's name.
+	Name string `json:"name"`
+	// The item's description.
+	Description string `json:"description"`
+	// The ID of the item's category, if any.If you provide the empty string for this value, any existing category association is
+	// removed from the item.
+	CategoryID string `json:"category_id"`
+	// The color of the item's display label in Square Register.
+	Color string `json:"color"`
+	// The text of the item's display label in Square Register. Only up to the first five
+	// characters of the string are used.
+	Abbreviation string `json:"abbreviation"`
+	// Indicates whether the item is viewable from the merchant's online store (PUBLIC) or
+	// PRIVATE.
+	Visibility string `json:"visibility"`
+	// If true, the item can be purchased from the merchant's online store.
+	AvailableOnline bool `json:"available_online"`
+	// If true, the item can be added to pickup orders from the merchant's online
+	// store.
+	AvailableForPickup bool `json:"available_for_pickup"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreateVariationReqObject struct {
+	// The variation's ID. Must be unique among all entity IDs ever provided on behalf of the
+	// merchant. You can never reuse an ID. This value can include alphanumeric
+	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
+	ID string `json:"id"`
+	// The item variation's name.
+	Name string `json:"name"`
+	// Indicates whether the item variation's price is fixed or determined at the time of
+	// sale.Default value: FIXED_PRICING
+	PricingType string `json:"pricing_type"`
+	// The item variation's price, if any.
+	PriceMoney Money `json:"price_money"`
+	// The item variation's SKU, if any.
+	Sku string `json:"sku"`
+	// If true, inventory tracking is active for the variation.Default value: false
+	TrackInventory bool `json:"track_inventory"`
+	// Indicates whether the item variation displays an alert when its inventory quantity is
+	// less than or equal to its inventory_alert_threshold.Default value: NONE
+	InventoryAlertType string `json:"inventory_alert_type"`
+	// If the inventory quantity for the variation is less than or equal to this value and
+	// inventory_alert_type is LOW_QUANTITY, the variation displays an alert in
+	// the merchant dashboard.This value is always an integer.Default value: 0
+	InventoryAlertThreshold int `json:"inventory_alert_threshold"`
+	// Arbitrary metadata to associate with the variation. Cannot exceed 255 characters.
+	UserData string `json:"user_data"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdateVariationReqObject struct {
+	// The item variation's name.
+	Name string `json:"name"`
+	// Indicates whether the item variation's price is fixed or determined at the time of
+	// sale.Default value: FIXED_PRICING
+	PricingType string `json:"pricing_type"`
+	// The item variation's price, if any.
+	PriceMoney Money `json:"price_money"`
+	// The item variation's SKU, if any.
+	Sku string `json:"sku"`
+	// If true, inventory tracking is active for the variation.
+	TrackInventory bool `json:"track_inventory"`
+	// Indicates whether the item variation displays an alert when its inventory quantity goes
+	// below its inventory_alert_threshold.
+	InventoryAlertType string `json:"inventory_alert_type"`
+	// If the inventory quantity for the variation is below this value and
+	// inventory_alert_type is LOW_QUANTITY, the variation displays an alert in
+	// the merchant dashboard.
+	InventoryAlertThreshold int `json:"inventory_alert_threshold"`
+	// Arbitrary metadata to associate with the variation. Cannot exceed 255 characters.
+	UserData string `json:"user_data"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type AdjustInventoryReqObject struct {
+	// The number to adjust the variation's quantity by.This value must be negative if adjustment_type is SALE, and it must be
+	// positive if adjustment_type is RECEIVE_STOCK.
+	QuantityDelta int `json:"quantity_delta"`
+	// The reason for the inventory adjustment.
+	AdjustmentType string `json:"adjustment_type"`
+	// A note about the inventory adjustment.
+	Memo string `json:"memo"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreateModifierListReqObject struct {
+	// The modifier list's ID. Must be unique among all entity IDs ever provided on behalf of
+	// the merchant. You can never reuse an ID. This value can include alphanumeric
+	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
+	ID string `json:"id"`
+	// The modifier list's name.
+	Name string `json:"name"`
+	// Indicates whether multiple options from the modifier list can be applied to a single
+	// item.Default value: SINGLE
+	SelectionType string `json:"selection_type"`
+	// The options included in the modifier list. You must include at least one modifier
+	// option.
+	ModifierOptions []ModifierOption `json:"modifier_options"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdateModifierListReqObject struct {
+	// The modifier list's name.
+	Name string `json:"name"`
+	// Indicates whether multiple options from the modifier list can be applied to a single
+	// item.
+	SelectionType string `json:"selection_type"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreateModifierOptionReqObject struct {
+	// The modifier option's ID. Must be unique among all entity IDs ever provided on behalf
+	// of the merchant. You can never reuse an ID. This value can include alphanumeric
+	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
+	ID string `json:"id"`
+	// The modifier option's name.
+	Name string `json:"name"`
+	// The modifier option's price.
+	PriceMoney Money `json:"price_money"`
+	// If true, the modifier option is the default option in a modifier list for which
+	// selection_type is SINGLE.Default value: false
+	OnByDefault bool `json:"on_by_default"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdateModifierOptionReqObject struct {
+	// The modifier option's name.
+	Name string `json:"name"`
+	// The modifier option's price.
+	PriceMoney Money `json:"price_money"`
+	// If true, the modifier option is the default option in a modifier list for which
+	// selection_type is SINGLE.
+	OnByDefault bool `json:"on_by_default"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreateCategoryReqObject struct {
+	// The category's ID. Must be unique among all entity IDs ever provided on behalf of the
+	// merchant. You can never reuse an ID. This value can include alphanumeric
+	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
+	ID string `json:"id"`
+	// The category's name.
+	Name string `json:"name"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdateCategoryReqObject struct {
+	// The new name of the category.
+	Name string `json:"name"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreateDiscountReqObject struct {
+	// The discount's ID. Must be unique among all entity IDs ever provided on behalf of the
+	// merchant. You can never reuse an ID. This value can include alphanumeric
+	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
+	ID string `json:"id"`
+	// The discount's name.
+	Name string `json:"name"`
+	// The rate of the discount, as a string representation of a decimal number. A value of
+	// 0.07 corresponds to a rate of 7%. Specify a rate of 0 if discount_type
+	// is VARIABLE_PERCENTAGE.Do not include this field for amount-based discounts.
+	Rate string `json:"rate"`
+	// The amount of the discount. Specify an amount of 0 if discount_type is
+	// VARIABLE_AMOUNT.Do not include this field for rate-based discounts.
+	AmountMoney Money `json:"amount_money"`
+	// Indicates whether the discount is a FIXED value or entered at the time of
+	// sale.Default value: FIXED
+	DiscountType string `json:"discount_type"`
+	// Indicates whether a mobile staff member needs to enter their PIN to apply the discount
+	// to a payment.Default value: false
+	PinRequired bool `json:"pin_required"`
+	// The color of the discount's display label in Square Register.Default value: 9da2a6
+	Color string `json:"color"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdateDiscountReqObject struct {
+	// The discount's name.
+	Name string `json:"name"`
+	// The rate of the discount, as a string representation of a decimal number. A value of
+	// 0.07 corresponds to a rate of 7%. Specify a rate of 0 if discount_type
+	// is VARIABLE_PERCENTAGE.Do not include this field for amount-based discounts.
+	Rate string `json:"rate"`
+	// The amount of the discount. Specify an amount of 0 if discount_type is
+	// VARIABLE_AMOUNT.Do not include this field for rate-based discounts.
+	AmountMoney Money `json:"amount_money"`
+	// Indicates whether the discount is a FIXED value or entered at the time of
+	// sale.Default value: FIXED
+	DiscountType string `json:"discount_type"`
+	// Indicates whether a mobile staff member needs to enter their PIN to apply the discount
+	// to a payment.Default value: false
+	PinRequired bool `json:"pin_required"`
+	// The color of the discount's display label in Square Register.Default value: 9da2a6
+	Color string `json:"color"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreateFeeReqObject struct {
+	// The fee's ID. Must be unique among all entity IDs ever provided on behalf of the
+	// merchant. You can never reuse an ID. This value can include alphanumeric
+	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
+	ID string `json:"id"`
+	// The fee's name.
+	Name string `json:"name"`
+	// The rate of the fee, as a string representation of a decimal number. A value of
+	// 0.07 corresponds to a rate of 7%.
+	Rate string `json:"rate"`
+	// Whether the fee is calculated based on a payment's subtotal or total.Default value: FEE_SUBTOTAL_PHASE
+	CalculationPhase string `json:"calculation_phase"`
+	// The type of adjustment the fee applies to a payment. Currently, this value is
+	// TAX for all fees.Default value: TAX
+	AdjustmentType string `json:"adjustment_type"`
+	// If true, the fee applies to custom amounts entered into Square Register that are
+	// not associated with a particular item.Default value: true
+	AppliesToCustomAmounts bool `json:"applies_to_custom_amounts"`
+	// If true, the fee is applied to payments. If false, it isn't.Default value: true
+	Enabled bool `json:"enabled"`
+	// Whether the fee is ADDITIVE or INCLUSIVE.Default value: ADDITIVE
+	InclusionType string `json:"inclusion_type"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdateFeeReqObject struct {
+	// The fee's name.
+	Name string `json:"name"`
+	// The rate of the fee, as a string representation of a decimal number. A value of
+	// 0.07 corresponds to a rate of 7%.
+	Rate string `json:"rate"`
+	// Whether the fee is calculated based on a payment's subtotal or total.Default value: FEE_SUBTOTAL_PHASE
+	CalculationPhase string `json:"calculation_phase"`
+	// The type of adjustment the fee applies to a payment. Currently, this value is
+	// TAX for all fees.Default value: TAX
+	AdjustmentType string `json:"adjustment_type"`
+	// If true, the fee applies to custom amounts entered into Square Register that are
+	// not associated with a particular item.Default value: true
+	AppliesToCustomAmounts bool `json:"applies_to_custom_amounts"`
+	// If true, the fee is applied to all appropriate items. If false, the fee
+	// is not applied at all.Default value: true
+	Enabled bool `json:"enabled"`
+	// Whether the fee is ADDITIVE or INCLUSIVE.Default value: ADDITIVE
+	InclusionType string `json:"inclusion_type"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type CreatePageReqObject struct {
+	// The page's ID. Must be unique among all entity IDs ever provided on behalf of the
+	// merchant. You can never reuse an ID. This value can include alphanumeric
+	// characters, dashes (-), and underscores (_).If you don't provide this value, an ID is generated by Square.
+	ID string `json:"id"`
+	// The page's name.
+	Name string `json:"name"`
+	// The page's position in the list of pages. Must be an integer between 0 and
+	// 4, inclusive.The endpoint returns an error if you specify a page_index that another page is
+	// already using.
+	PageIndex int `json:"page_index"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdatePageReqObject struct {
+	// The page's name.
+	Name string `json:"name"`
+	// The page's position in the merchant's list of pages. Must be an integer between
+	// 0 and 4, inclusive.The page's index is not updated if another page already exists at the specified
+	// index.
+	PageIndex int `json:"page_index"`
+}
+
+// For POST and PUT endpoints, you provide request parameters as JSON in your request's body.
+type UpdateCellReqObject struct {
+	// The row of the cell to update. Always an integer between 0 and 4,
+	// inclusive. Row 0 is the top row.
+	Row int `json:"row"`
+	// The column of the cell to update. Always an integer between 0 and 4,
+	// inclusive. Column 0 is the leftmost row.
+	Column int `json:"column"`
+	// The type of entity represented in the cell (ITEM, DISCOUNT,
+	// CATEGORY, or PLACEHOLDER).
+	ObjectType string `json:"object_type"`
+	// The unique identifier of the entity to represent in the cell. Do not include if the
+	// cell's object_type is PLACEHOLDER.
+	ObjectID string `json:"object_id"`
+	// For a cell with an object_type of PLACEHOLDER, indicates the cell's
+	// behavior.
+	PlaceholderType string `json:"placeholder_type"`
+}
@@ -0,0 +1,158 @@
+package gosquare
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how a Client retries failed requests.
+// A request is retried when Square answers with a retryable status
+// (see IsRetryable) or when the request fails before getting a response,
+// as long as the method is idempotent (GET, PUT and DELETE) or the call
+// was made with a context returned by AllowWriteRetry.
+// A batch submitted with SubmitBatch counts as idempotent when none of its
+// requests is a POST.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made, including the first.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// MinBackoff is the base delay before the first retry, it doubles with
+	// every attempt up to MaxBackoff. A random jitter of up to half the delay
+	// is subtracted from every wait.
+	MinBackoff time.Duration
+	// MaxBackoff caps the exponential backoff.
+	MaxBackoff time.Duration
+	// If a failed response carries a "Retry-After" header, the client waits
+	// at least that long before retrying. MaxRetryAfter, if non-zero, is the
+	// longest such wait honored, a response asking for more is not retried.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryPolicy is a reasonable RetryPolicy for most uses:
+//
+//	client.Retry = &gosquare.DefaultRetryPolicy
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:   4,
+	MinBackoff:    500 * time.Millisecond,
+	MaxBackoff:    30 * time.Second,
+	MaxRetryAfter: 2 * time.Minute,
+}
+
+type writeRetryKey struct{}
+
+// AllowWriteRetry returns a context that lets the Client retry the non-idempotent
+// (POST) calls it is used for. Only use it for calls that are safe to repeat,
+// for example a CreateRefund with a request_idempotence_key or a CreateItem with
+// a client-generated id.
+func AllowWriteRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, writeRetryKey{}, true)
+}
+
+func writeRetryAllowed(ctx context.Context) bool {
+	v, _ := ctx.Value(writeRetryKey{}).(bool)
+	return v
+}
+
+func isIdempotent(ctx context.Context, method string) bool {
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE":
+		return true
+	}
+	return writeRetryAllowed(ctx)
+}
+
+func hasWrites(batchRequests []*BatchRequest) bool {
+	for _, br := range batchRequests {
+		if !isIdempotent(context.Background(), br.Method) {
+			return true
+		}
+	}
+	return false
+}
+
+// retry calls attempt until it succeeds or the client's RetryPolicy gives up.
+func (c *Client) retry(ctx context.Context, r *Request, attempt func() (*Response, error)) (*Response, error) {
+	p := c.Retry
+	for n := 1; ; n++ {
+		resp, err := attempt()
+		if err == nil || p == nil || n >= p.MaxAttempts || !isIdempotent(ctx, r.Method) {
+			return resp, err
+		}
+		wait, ok := p.backoff(ctx, n, err)
+		if !ok {
+			return nil, err
+		}
+		c.logRetry(ctx, r.Method, r.Path, n, wait, err)
+		if c.Metrics != nil {
+			c.Metrics.IncRetries(r.Operation)
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, err
+		case <-t.C:
+		}
+	}
+}
+
+// backoff returns how long to wait before attempt n+1,
+// or false if err should not be retried.
+func (p *RetryPolicy) backoff(ctx context.Context, n int, err error) (time.Duration, bool) {
+	if ctx.Err() != nil {
+		return 0, false
+	}
+	var retryAfter time.Duration
+	if e, ok := asAPIError(err); ok {
+		if !IsRetryable(e) {
+			return 0, false
+		}
+		retryAfter = parseRetryAfter(e.Header.Get("Retry-After"))
+		if p.MaxRetryAfter > 0 && retryAfter > p.MaxRetryAfter {
+			return 0, false
+		}
+	} else {
+		// besides error responses only transport failures are worth
+		// another attempt, not a body that failed to decode
+		var ue *url.Error
+		if !errors.As(err, &ue) {
+			return 0, false
+		}
+	}
+	wait := p.MinBackoff
+	for i := 1; i < n && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait > 1 {
+		wait -= rand.N(wait / 2)
+	}
+	if retryAfter > wait {
+		wait = retryAfter
+	}
+	return wait, true
+}
+
+// parseRetryAfter parses a "Retry-After" header value,
+// which is either a number of seconds or an HTTP date.
+func parseRetryAfter(v string) time.Duration {
+	if len(v) == 0 {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,179 @@
+package gosquare
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		v    string
+		want time.Duration
+	}{
+		{"", 0},
+		{"5", 5 * time.Second},
+		{"0", 0},
+		{"-3", 0},
+		{"soon", 0},
+		{time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.v); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+	date := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+	if got := parseRetryAfter(date); got < 28*time.Second || got > 30*time.Second {
+		t.Errorf("parseRetryAfter(%q) = %v, want about 30s", date, got)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	ctx := context.Background()
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, MaxRetryAfter: 10 * time.Second}
+	unavailable := &APIError{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	for n, want := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		want *= time.Millisecond
+		wait, ok := p.backoff(ctx, n+1, unavailable)
+		if !ok || wait < want/2 || wait > want {
+			t.Errorf("backoff after attempt %d = %v, %v, want %v minus up to half", n+1, wait, ok, want)
+		}
+	}
+
+	rateLimited := func(retryAfter string) error {
+		return &APIError{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {retryAfter}}}
+	}
+	if wait, ok := p.backoff(ctx, 1, rateLimited("5")); !ok || wait != 5*time.Second {
+		t.Errorf("backoff with Retry-After: 5 = %v, %v, want 5s", wait, ok)
+	}
+	date := time.Now().Add(8 * time.Second).UTC().Format(http.TimeFormat)
+	if wait, ok := p.backoff(ctx, 1, rateLimited(date)); !ok || wait < 6*time.Second || wait > 8*time.Second {
+		t.Errorf("backoff with Retry-After: %s = %v, %v, want about 8s", date, wait, ok)
+	}
+	if _, ok := p.backoff(ctx, 1, rateLimited("60")); ok {
+		t.Error("a Retry-After over MaxRetryAfter is retried")
+	}
+	if wait, ok := (&RetryPolicy{}).backoff(ctx, 1, rateLimited("60")); !ok || wait != time.Minute {
+		t.Errorf("backoff without MaxRetryAfter = %v, %v, want 1m", wait, ok)
+	}
+
+	if _, ok := p.backoff(ctx, 1, &APIError{StatusCode: http.StatusNotFound}); ok {
+		t.Error("a 404 is retried")
+	}
+	if _, ok := p.backoff(ctx, 1, &url.Error{Op: "Get", URL: "https://connect.squareup.com/v1/me", Err: io.ErrUnexpectedEOF}); !ok {
+		t.Error("a transport error isn't retried")
+	}
+	if _, ok := p.backoff(ctx, 1, errors.New("invalid character")); ok {
+		t.Error("a decoding error is retried")
+	}
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, ok := p.backoff(cancelled, 1, unavailable); ok {
+		t.Error("a cancelled call is retried")
+	}
+}
+
+// retryServer answers the first request it gets with fail
+// and the following ones with body.
+func retryServer(t *testing.T, fail http.HandlerFunc, body string) (*Client, *atomic.Int32) {
+	hits := new(atomic.Int32)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			fail(w, r)
+			return
+		}
+		io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+	c := NewClient(srv.URL, "TOKEN")
+	c.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond, MaxRetryAfter: time.Minute}
+	return c, hits
+}
+
+func statusHandler(code int, retryAfter string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if len(retryAfter) > 0 {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.WriteHeader(code)
+		io.WriteString(w, `{"type":"error","message":"try again"}`)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("rate limited", func(t *testing.T) {
+		c, hits := retryServer(t, statusHandler(http.StatusTooManyRequests, ""), `{"id":"M"}`)
+		if m, err := c.RetrieveBusiness(""); err != nil || m.ID != "M" || hits.Load() != 2 {
+			t.Errorf("got %v, %v after %d requests, want a retried success", m, err, hits.Load())
+		}
+	})
+	t.Run("Retry-After", func(t *testing.T) {
+		c, hits := retryServer(t, statusHandler(http.StatusTooManyRequests, "1"), `{"id":"M"}`)
+		start := time.Now()
+		if m, err := c.RetrieveBusiness(""); err != nil || m.ID != "M" || hits.Load() != 2 {
+			t.Errorf("got %v, %v after %d requests, want a retried success", m, err, hits.Load())
+		}
+		if d := time.Since(start); d < time.Second {
+			t.Errorf("retried after %v, before the 1s Retry-After", d)
+		}
+	})
+	t.Run("Retry-After over MaxRetryAfter", func(t *testing.T) {
+		c, hits := retryServer(t, statusHandler(http.StatusTooManyRequests, "120"), `{"id":"M"}`)
+		if _, err := c.RetrieveBusiness(""); !IsRateLimited(err) || hits.Load() != 1 {
+			t.Errorf("got %v after %d requests, want the 429 unretried", err, hits.Load())
+		}
+	})
+	t.Run("POST", func(t *testing.T) {
+		c, hits := retryServer(t, statusHandler(http.StatusServiceUnavailable, ""), `{"id":"E"}`)
+		if _, err := c.CreateEmployee("", &CreateEmployeeReqObject{}); !IsRetryable(err) || hits.Load() != 1 {
+			t.Errorf("got %v after %d requests, want the 503 unretried", err, hits.Load())
+		}
+	})
+	t.Run("POST with AllowWriteRetry", func(t *testing.T) {
+		c, hits := retryServer(t, statusHandler(http.StatusServiceUnavailable, ""), `{"id":"E"}`)
+		ctx := AllowWriteRetry(context.Background())
+		if e, err := c.CreateEmployeeContext(ctx, "", &CreateEmployeeReqObject{}); err != nil || e.ID != "E" || hits.Load() != 2 {
+			t.Errorf("got %v, %v after %d requests, want a retried success", e, err, hits.Load())
+		}
+	})
+	t.Run("batch with a POST", func(t *testing.T) {
+		c, hits := retryServer(t, statusHandler(http.StatusServiceUnavailable, ""), `[]`)
+		reqs := []*BatchRequest{
+			{Method: "GET", RelativePath: "/v1/me", RequestID: "1"},
+			{Method: "POST", RelativePath: "/v1/me/employees", RequestID: "2"},
+		}
+		if _, err := c.SubmitBatch("", reqs); !IsRetryable(err) || hits.Load() != 1 {
+			t.Errorf("got %v after %d requests, want the 503 unretried", err, hits.Load())
+		}
+	})
+	t.Run("batch without a POST", func(t *testing.T) {
+		c, hits := retryServer(t, statusHandler(http.StatusServiceUnavailable, ""), `[]`)
+		reqs := []*BatchRequest{
+			{Method: "GET", RelativePath: "/v1/me", RequestID: "1"},
+			{Method: "DELETE", RelativePath: "/v1/me/employees/E", RequestID: "2"},
+		}
+		if _, err := c.SubmitBatch("", reqs); err != nil || hits.Load() != 2 {
+			t.Errorf("got %v after %d requests, want a retried success", err, hits.Load())
+		}
+	})
+	t.Run("transport error", func(t *testing.T) {
+		c, hits := retryServer(t, func(w http.ResponseWriter, r *http.Request) {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			conn.Close()
+		}, `{"id":"M"}`)
+		if m, err := c.RetrieveBusiness(""); err != nil || m.ID != "M" || hits.Load() != 2 {
+			t.Errorf("got %v, %v after %d requests, want a retried success", m, err, hits.Load())
+		}
+	})
+}
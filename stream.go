@@ -0,0 +1,111 @@
+package gosquare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// The Stream methods call a function with every result of a list endpoint,
+// following every page like the Iter methods, but decode each response body
+// one result at a time instead of into a slice, so a page is never held in
+// memory as a whole:
+//
+//	err := client.StreamPayments(ctx, "", locationID, nil, func(p *gosquare.Payment) error {
+//		...
+//		return nil
+//	})
+//
+// An error returned by fn ends the stream and is returned, ErrStopStream ends
+// it without an error. Either way the rest of the response body is not read
+// and no other page is fetched. Results fn was called with before an error
+// occurred, in a page or in a following one, are not taken back.
+
+// ErrStopStream may be returned by the function passed to a Stream method to
+// stop streaming early. The Stream method then returns nil.
+var ErrStopStream = errors.New("gosquare: stop streaming")
+
+// streamDecoder is implemented by the results of requests that decode their
+// response body themselves, sendRequest hands them the decoder of the body.
+type streamDecoder interface {
+	decodeStream(dec *json.Decoder) error
+}
+
+// streamResult decodes a JSON array, calling fn with each of its elements.
+// An error returned by fn ends the decoding and is kept in err, leaving the
+// request itself successful.
+type streamResult[T any] struct {
+	fn  func(T) error
+	err error
+}
+
+func (s *streamResult[T]) decodeStream(dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err == io.EOF {
+		// no body at all, an empty page
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		// null, an empty page
+		return nil
+	}
+	if tok != json.Delim('[') {
+		return fmt.Errorf("gosquare: expected a JSON array, got %v", tok)
+	}
+	for dec.More() {
+		var v T
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		if err := s.fn(v); err != nil {
+			s.err = err
+			return nil
+		}
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// StreamFrom calls fn with every result of nr's page and of every page
+// following it, decoding them one at a time, for example to resume a walk
+// from a cursor.
+func StreamFrom[T any](ctx context.Context, nr *NextRequest, fn func(T) error) error {
+	s := &streamResult[T]{fn: fn}
+	return follow(ctx, s, nr)
+}
+
+// stream calls fn with every result of the list endpoint at path and of
+// every page following it.
+func stream[T any](ctx context.Context, c *Client, operation, path, token string, fn func(T) error) error {
+	s := &streamResult[T]{fn: fn}
+	nr, err := c.squareRequest(ctx, operation, "GET", path, token, nil, s)
+	if err != nil {
+		return err
+	}
+	return follow(ctx, s, nr)
+}
+
+// follow streams the page requested by nr and the pages following it
+// through s, unless fn ended the stream in the page before.
+func follow[T any](ctx context.Context, s *streamResult[T], nr *NextRequest) error {
+	for {
+		if s.err != nil {
+			if errors.Is(s.err, ErrStopStream) {
+				return nil
+			}
+			return s.err
+		}
+		if nr == nil {
+			return nil
+		}
+		var err error
+		if nr, err = nr.GetNextRequestContext(ctx, s); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package gosquare_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+)
+
+func TestStream(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	srv.SetPageSize(2)
+	seedPayments(srv, "L", 5)
+	c := srv.Client()
+	ctx := context.Background()
+
+	var ids []string
+	err := c.StreamPayments(ctx, "", "L", nil, func(p *gosquare.Payment) error {
+		ids = append(ids, p.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(ids), "[P000 P001 P002 P003 P004]"; got != want {
+		t.Errorf("streamed %s, want %s", got, want)
+	}
+	if n := len(srv.Requests()); n != 3 {
+		t.Errorf("fetched %d pages, want 3", n)
+	}
+}
+
+func TestStreamStop(t *testing.T) {
+	errBoom := errors.New("boom")
+	for _, tt := range []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"ErrStopStream", gosquare.ErrStopStream, nil},
+		{"error", errBoom, errBoom},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := gosquaretest.NewServer()
+			defer srv.Close()
+			srv.SetPageSize(2)
+			seedPayments(srv, "L", 5)
+			c := srv.Client()
+
+			calls := 0
+			err := c.StreamPayments(context.Background(), "", "L", nil, func(p *gosquare.Payment) error {
+				if calls++; calls == 3 {
+					return tt.err
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+				t.Errorf("got error %v, want %v", err, tt.want)
+			}
+			if calls != 3 {
+				t.Errorf("fn called %d times after stopping at 3", calls)
+			}
+			if n := len(srv.Requests()); n != 2 {
+				t.Errorf("fetched %d pages, want 2", n)
+			}
+		})
+	}
+}
+
+func TestStreamEmptyBody(t *testing.T) {
+	for _, body := range []string{"null", "[]", " [ ] ", ""} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, body)
+		}))
+		c := gosquare.NewClient(srv.URL, "TOKEN")
+		calls := 0
+		err := c.StreamPayments(context.Background(), "", "L", nil, func(p *gosquare.Payment) error {
+			calls++
+			return nil
+		})
+		if err != nil || calls != 0 {
+			t.Errorf("streaming the body %q = %v after %d calls, want no calls", body, err, calls)
+		}
+		srv.Close()
+	}
+}
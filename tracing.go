@@ -0,0 +1,139 @@
+package gosquare
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Tracer starts the spans recorded for a Client's calls. Set it as Client.Tracer,
+// see the otelsquare package for an OpenTelemetry implementation.
+//
+// Every call gets a span named "gosquare.<Operation>", for example
+// "gosquare.ListPayments". The pages followed through a NextRequest are
+// children of the span of the call that returned it, and every request
+// in a SubmitBatch call gets a child span of the batch's span.
+type Tracer interface {
+	// Start starts a span as a child of the span in ctx, if any,
+	// and returns a context holding the new span.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// SetAttributes adds attributes to the span.
+	SetAttributes(attrs ...Attribute)
+	// End ends the span, marking it as failed if err isn't nil.
+	End(err error)
+}
+
+// Attribute is a key/value pair attached to a Span.
+// Value is always a string, an int or a bool.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// The attribute keys set on spans.
+const (
+	AttrOperation   = "gosquare.operation"
+	AttrMethod      = "http.request.method"
+	AttrPath        = "gosquare.path"
+	AttrLocationID  = "gosquare.location_id"
+	AttrStatusCode  = "http.response.status_code"
+	AttrPage        = "gosquare.page"
+	AttrBatchSize   = "gosquare.batch.size"
+	AttrHasNextPage = "gosquare.has_next_page"
+)
+
+// traced wraps h so every call is recorded by the client's Tracer, if any.
+func (c *Client) traced(h Handler) Handler {
+	return func(ctx context.Context, r *Request) (*Response, error) {
+		t := c.Tracer
+		if t == nil {
+			return h(ctx, r)
+		}
+		ctx, span := t.Start(ctx, spanName(r.Operation), requestAttributes(r.Operation, r.Method, r.Path)...)
+		if r.Page > 0 {
+			span.SetAttributes(Attribute{AttrPage, r.Page})
+		}
+		var batchSpans []Span
+		if r.Batch != nil {
+			span.SetAttributes(Attribute{AttrBatchSize, len(r.Batch)})
+			batchSpans = make([]Span, len(r.Batch))
+			for i, br := range r.Batch {
+				_, batchSpans[i] = t.Start(ctx, spanName(br.operation), requestAttributes(br.operation, br.Method, br.RelativePath)...)
+			}
+		}
+		resp, err := h(ctx, r)
+		if e, ok := asAPIError(err); ok {
+			span.SetAttributes(Attribute{AttrStatusCode, e.StatusCode})
+		}
+		if resp != nil {
+			span.SetAttributes(
+				Attribute{AttrStatusCode, resp.StatusCode},
+				Attribute{AttrHasNextPage, resp.NextRequest != nil},
+			)
+		}
+		if batchSpans != nil {
+			endBatchSpans(r, resp, err, batchSpans)
+		}
+		span.End(err)
+		return resp, err
+	}
+}
+
+// endBatchSpans ends the span of every batched request with its own outcome.
+func endBatchSpans(r *Request, resp *Response, err error, spans []Span) {
+	byID := make(map[string]*BatchResponse)
+	if resp != nil {
+		if v, ok := resp.Result.(*[]*BatchResponse); ok {
+			for _, br := range *v {
+				byID[br.RequestID] = br
+			}
+		}
+	}
+	for i, br := range r.Batch {
+		bResp, ok := byID[br.RequestID]
+		switch {
+		case ok:
+			spans[i].SetAttributes(Attribute{AttrStatusCode, bResp.StatusCode})
+			spans[i].End(bResp.Err())
+		case err != nil:
+			spans[i].End(err)
+		default:
+			spans[i].End(fmt.Errorf("gosquare: no response for batched request"))
+		}
+	}
+}
+
+func spanName(operation string) string {
+	if len(operation) == 0 {
+		return "gosquare.request"
+	}
+	return "gosquare." + operation
+}
+
+func requestAttributes(operation, method, path string) []Attribute {
+	attrs := []Attribute{
+		{AttrOperation, operation},
+		{AttrMethod, method},
+		{AttrPath, path},
+	}
+	if id := locationID(path); len(id) > 0 {
+		attrs = append(attrs, Attribute{AttrLocationID, id})
+	}
+	return attrs
+}
+
+// locationID returns the location id in a "/v1/LOCATION_ID/..." path, if any.
+func locationID(path string) string {
+	if i := strings.IndexByte(path, '?'); i > -1 {
+		path = path[:i]
+	}
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segs) < 3 || segs[0] != "v1" || segs[1] == "me" {
+		return ""
+	}
+	return segs[1]
+}
@@ -0,0 +1,137 @@
+package gosquare_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/nathanjsweet/gosquare"
+	"github.com/nathanjsweet/gosquare/gosquaretest"
+)
+
+// fakeTracer records the spans it starts.
+type fakeTracer struct {
+	mu    sync.Mutex
+	spans []*fakeSpan
+}
+
+type fakeSpan struct {
+	name   string
+	parent *fakeSpan
+	attrs  map[string]interface{}
+	ended  bool
+	err    error
+}
+
+type spanKey struct{}
+
+func (t *fakeTracer) Start(ctx context.Context, name string, attrs ...gosquare.Attribute) (context.Context, gosquare.Span) {
+	parent, _ := ctx.Value(spanKey{}).(*fakeSpan)
+	s := &fakeSpan{name: name, parent: parent, attrs: make(map[string]interface{})}
+	s.SetAttributes(attrs...)
+	t.mu.Lock()
+	t.spans = append(t.spans, s)
+	t.mu.Unlock()
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func (s *fakeSpan) SetAttributes(attrs ...gosquare.Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *fakeSpan) End(err error) {
+	s.ended, s.err = true, err
+}
+
+func TestTracerPages(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	srv.SetPageSize(2)
+	seedPayments(srv, "L", 3)
+	c := srv.Client()
+	tracer := new(fakeTracer)
+	c.Tracer = tracer
+
+	var page []*gosquare.Payment
+	_, nr, err := c.ListPaymentsWithOptions("", "L", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := nr.GetNextRequest(&page); err != nil {
+		t.Fatal(err)
+	}
+	if len(tracer.spans) != 2 {
+		t.Fatalf("recorded %d spans, want 2", len(tracer.spans))
+	}
+	first, next := tracer.spans[0], tracer.spans[1]
+	if first.name != "gosquare.ListPayments" || first.parent != nil || !first.ended || first.err != nil {
+		t.Errorf("first page span = %+v", first)
+	}
+	for k, v := range map[string]interface{}{
+		gosquare.AttrOperation:   "ListPayments",
+		gosquare.AttrMethod:      "GET",
+		gosquare.AttrLocationID:  "L",
+		gosquare.AttrStatusCode:  200,
+		gosquare.AttrHasNextPage: true,
+	} {
+		if first.attrs[k] != v {
+			t.Errorf("first page span %s = %v, want %v", k, first.attrs[k], v)
+		}
+	}
+	if next.name != "gosquare.ListPayments" || next.parent != first || !next.ended {
+		t.Errorf("next page span = %+v, want a child of the first page's", next)
+	}
+	if next.attrs[gosquare.AttrPage] != 1 || next.attrs[gosquare.AttrHasNextPage] != false {
+		t.Errorf("next page span attributes = %v", next.attrs)
+	}
+}
+
+func TestTracerBatch(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	seedPayments(srv, "L", 1)
+	c := srv.Client()
+	tracer := new(fakeTracer)
+	c.Tracer = tracer
+
+	found := c.RetrievePaymentBatchCall("", "L", "P000")
+	missing := c.RetrievePaymentBatchCall("", "L", "missing")
+	if _, err := c.SubmitBatch("", []*gosquare.BatchRequest{found.BatchRequest, missing.BatchRequest}); err != nil {
+		t.Fatal(err)
+	}
+	if len(tracer.spans) != 3 {
+		t.Fatalf("recorded %d spans, want 3", len(tracer.spans))
+	}
+	batch := tracer.spans[0]
+	if batch.name != "gosquare.SubmitBatch" || batch.attrs[gosquare.AttrBatchSize] != 2 ||
+		batch.attrs[gosquare.AttrStatusCode] != 200 || batch.err != nil {
+		t.Errorf("batch span = %+v", batch)
+	}
+	for i, want := range []int{200, 404} {
+		s := tracer.spans[i+1]
+		if s.name != "gosquare.RetrievePayment" || s.parent != batch || !s.ended {
+			t.Errorf("batched request span %d = %+v, want a child of the batch's", i, s)
+		}
+		if s.attrs[gosquare.AttrStatusCode] != want || (s.err != nil) != (want != 200) {
+			t.Errorf("batched request span %d has status %v and error %v, want %d", i, s.attrs[gosquare.AttrStatusCode], s.err, want)
+		}
+	}
+}
+
+func TestTracerError(t *testing.T) {
+	srv := gosquaretest.NewServer()
+	defer srv.Close()
+	c := srv.Client()
+	tracer := new(fakeTracer)
+	c.Tracer = tracer
+
+	if _, err := c.RetrievePayment("", "L", "missing"); !gosquare.IsNotFound(err) {
+		t.Fatalf("got %v, want a 404", err)
+	}
+	s := tracer.spans[0]
+	if !gosquare.IsNotFound(s.err) || s.attrs[gosquare.AttrStatusCode] != 404 || s.attrs[gosquare.AttrLocationID] != "L" {
+		t.Errorf("failed call span = %+v", s)
+	}
+}
@@ -0,0 +1,67 @@
+package gosquare
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+)
+
+// Uploads a JPEG or PNG image and sets it as the master image for an item. See this article for recommended
+// specifications for item images.
+//
+// If you upload an image for an item that already has a master image, the new image replaces
+// the existing one.
+//
+// Important: Requests to this endpoint use the Content-Type: multipart/form-data
+// header instead of Content-Type: application/json. It's recommended that you use an HTTP
+// library (such as Requests for
+// Python) that simplifies the process for sending multipart/form-data requests.
+//
+// The example request body shown assumes that you've set your request's multipart boundary to
+// BOUNDARY in your Content-Type header, like so:
+//
+// Content-Type: multipart/form-data; boundary=BOUNDARY
+//
+// Note that some HTTP libraries set your request's multipart boundary for you.
+//
+// Required permissions:  ITEMS_WRITE
+func (c *Client) UploadItemImage(token, locationID, itemID, imageName, imageMime string, body io.Reader) (*ItemImage, error) {
+	return c.UploadItemImageContext(context.Background(), token, locationID, itemID, imageName, imageMime, body)
+}
+
+// UploadItemImageContext is like UploadItemImage but uses ctx for the request.
+func (c *Client) UploadItemImageContext(ctx context.Context, token, locationID, itemID, imageName, imageMime string, body io.Reader) (*ItemImage, error) {
+	v := new(ItemImage)
+	b := bytes.NewBuffer(make([]byte, 0))
+	bw := multipart.NewWriter(b)
+	boundary := bw.Boundary()
+	mh := make(textproto.MIMEHeader)
+	mh.Set("Content-Type", imageMime)
+	mh.Set("Content-Disposition", fmt.Sprintf(`form-data; name="image_data"; filename="%s"`, imageName))
+	pw, err := bw.CreatePart(mh)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.Copy(pw, body); err != nil {
+		return nil, err
+	}
+	if err = bw.Close(); err != nil {
+		return nil, err
+	}
+	_, err = c.baseSquareRequest(ctx, &Request{
+		Operation:   "UploadItemImage",
+		Method:      "POST",
+		Path:        fmt.Sprintf("/v1/%s/items/%s/image", locationID, itemID),
+		Token:       token,
+		ContentType: fmt.Sprintf("multipart/form-data; boundary=%s", boundary),
+		Body:        b.Bytes(),
+		Result:      v,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}